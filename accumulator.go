@@ -0,0 +1,227 @@
+package godino
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// A streaming aggregate of numbers which tracks the count, sum, mean, variance, minimum and maximum
+// without storing the values. Accumulators can be fed incrementally, merged and serialized to JSON.
+// The zero value is an empty accumulator ready to use.
+type Accumulator[T Real] struct {
+	count        int
+	sum          float64
+	compensation float64
+	mean         float64
+	m2           float64
+	min          T
+	max          T
+}
+
+// Returns a new accumulator containing the given values
+func NewAccumulator[T Real](values ...T) *Accumulator[T] {
+	a := &Accumulator[T]{}
+	a.Add(values...)
+	return a
+}
+
+// Adds the values to the accumulator
+func (a *Accumulator[T]) Add(values ...T) {
+	for _, v := range values {
+		if a.count == 0 || v < a.min {
+			a.min = v
+		}
+		if a.count == 0 || v > a.max {
+			a.max = v
+		}
+		a.count++
+		x := float64(v)
+		a.addToSum(x)
+		// Welford's online algorithm
+		delta := x - a.mean
+		a.mean += delta / float64(a.count)
+		a.m2 += delta * (x - a.mean)
+	}
+}
+
+// Neumaier's compensated summation
+func (a *Accumulator[T]) addToSum(x float64) {
+	t := a.sum + x
+	if math.Abs(a.sum) >= math.Abs(x) {
+		a.compensation += (a.sum - t) + x
+	} else {
+		a.compensation += (x - t) + a.sum
+	}
+	a.sum = t
+}
+
+// Returns the number of values added to the accumulator
+func (a Accumulator[T]) Count() int {
+	return a.count
+}
+
+// Returns the largest value added to the accumulator. Returns an error if the accumulator is empty.
+func (a Accumulator[T]) Max() (T, error) {
+	if a.count == 0 {
+		return a.max, errors.New("Max() called on an empty accumulator")
+	}
+	return a.max, nil
+}
+
+// Returns the arithmetic mean of the values added to the accumulator. Returns an error if the accumulator is empty.
+func (a Accumulator[T]) Mean() (float64, error) {
+	if a.count == 0 {
+		return 0, errors.New("Mean() called on an empty accumulator")
+	}
+	return a.mean, nil
+}
+
+// Combines the values of another accumulator into this one, as if they had all been added to this accumulator.
+// Useful for aggregating in several goroutines and combining the results.
+func (a *Accumulator[T]) Merge(other Accumulator[T]) {
+	if other.count == 0 {
+		return
+	}
+	if a.count == 0 {
+		*a = other
+		return
+	}
+	if other.min < a.min {
+		a.min = other.min
+	}
+	if other.max > a.max {
+		a.max = other.max
+	}
+	n := float64(a.count + other.count)
+	delta := other.mean - a.mean
+	a.m2 += other.m2 + delta*delta*float64(a.count)*float64(other.count)/n
+	a.mean += delta * float64(other.count) / n
+	a.count += other.count
+	a.addToSum(other.sum)
+	a.compensation += other.compensation
+}
+
+// Returns the smallest value added to the accumulator. Returns an error if the accumulator is empty.
+func (a Accumulator[T]) Min() (T, error) {
+	if a.count == 0 {
+		return a.min, errors.New("Min() called on an empty accumulator")
+	}
+	return a.min, nil
+}
+
+// Returns the population standard deviation of the values. Returns an error if the accumulator is empty.
+func (a Accumulator[T]) PStdev() (float64, error) {
+	variance, err := a.PVariance()
+	if err != nil {
+		return 0, errors.New("PStdev() called on an empty accumulator")
+	}
+	return math.Sqrt(variance), nil
+}
+
+// Returns the population variance of the values. Returns an error if the accumulator is empty.
+func (a Accumulator[T]) PVariance() (float64, error) {
+	if a.count == 0 {
+		return 0, errors.New("PVariance() called on an empty accumulator")
+	}
+	return a.m2 / float64(a.count), nil
+}
+
+// Returns the sample standard deviation of the values. Returns an error if fewer than 2 values have been added.
+func (a Accumulator[T]) Stdev() (float64, error) {
+	variance, err := a.Variance()
+	if err != nil {
+		return 0, errors.New("Stdev() requires at least 2 values")
+	}
+	return math.Sqrt(variance), nil
+}
+
+// Returns the sum of the values added to the accumulator
+func (a Accumulator[T]) Sum() float64 {
+	// Once the sum is infinite or NaN the compensation is meaningless and may be NaN
+	if math.IsInf(a.sum, 0) || math.IsNaN(a.sum) {
+		return a.sum
+	}
+	return a.sum + a.compensation
+}
+
+// Returns the sample variance of the values. Returns an error if fewer than 2 values have been added.
+func (a Accumulator[T]) Variance() (float64, error) {
+	if a.count < 2 {
+		return 0, errors.New("Variance() requires at least 2 values")
+	}
+	return a.m2 / float64(a.count-1), nil
+}
+
+// A number in the JSON encoding of an accumulator. Infinities and NaN, which JSON numbers can't represent,
+// are encoded as the strings "+Inf", "-Inf" and "NaN".
+type accumulatorNumber[T Real] struct {
+	value T
+}
+
+func (n accumulatorNumber[T]) MarshalJSON() ([]byte, error) {
+	if f := float64(n.value); math.IsInf(f, 0) || math.IsNaN(f) {
+		return json.Marshal(strconv.FormatFloat(f, 'g', -1, 64))
+	}
+	return json.Marshal(n.value)
+}
+
+func (n *accumulatorNumber[T]) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return json.Unmarshal(data, &n.value)
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil || !(math.IsInf(f, 0) || math.IsNaN(f)) {
+		return fmt.Errorf("accumulator number %q is not a JSON number, \"+Inf\", \"-Inf\" or \"NaN\"", s)
+	}
+	n.value = T(f)
+	return nil
+}
+
+type accumulatorJSON[T Real] struct {
+	Count        int                        `json:"count"`
+	Sum          accumulatorNumber[float64] `json:"sum"`
+	Compensation accumulatorNumber[float64] `json:"compensation"`
+	Mean         accumulatorNumber[float64] `json:"mean"`
+	M2           accumulatorNumber[float64] `json:"m2"`
+	Min          accumulatorNumber[T]       `json:"min"`
+	Max          accumulatorNumber[T]       `json:"max"`
+}
+
+// Encodes the state of the accumulator as JSON so it can be stored and restored with UnmarshalJSON.
+// Infinities and NaN are encoded as the strings "+Inf", "-Inf" and "NaN".
+func (a Accumulator[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(accumulatorJSON[T]{
+		Count:        a.count,
+		Sum:          accumulatorNumber[float64]{a.sum},
+		Compensation: accumulatorNumber[float64]{a.compensation},
+		Mean:         accumulatorNumber[float64]{a.mean},
+		M2:           accumulatorNumber[float64]{a.m2},
+		Min:          accumulatorNumber[T]{a.min},
+		Max:          accumulatorNumber[T]{a.max},
+	})
+}
+
+// Restores the state of the accumulator from JSON produced by MarshalJSON
+func (a *Accumulator[T]) UnmarshalJSON(data []byte) error {
+	var state accumulatorJSON[T]
+	if err := json.Unmarshal(data, &state); err != nil {
+		return err
+	}
+	if state.Count < 0 {
+		return errors.New("accumulator count cannot be negative")
+	}
+	*a = Accumulator[T]{
+		count:        state.Count,
+		sum:          state.Sum.value,
+		compensation: state.Compensation.value,
+		mean:         state.Mean.value,
+		m2:           state.M2.value,
+		min:          state.Min.value,
+		max:          state.Max.value,
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package godino
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+func ExampleAccumulator() {
+	a := NewAccumulator(2, 4, 4, 4)
+	a.Add(5, 5, 7, 9)
+
+	mean, _ := a.Mean()
+	stdev, _ := a.PStdev()
+	min, _ := a.Min()
+	max, _ := a.Max()
+	fmt.Println(a.Count(), a.Sum(), mean, stdev, min, max)
+	// Output: 8 40 5 2 2 9
+}
+
+func ExampleAccumulator_Merge() {
+	a := NewAccumulator(1, 2, 3)
+	b := NewAccumulator(4, 5, 6)
+	a.Merge(*b)
+
+	mean, _ := a.Mean()
+	fmt.Println(a.Count(), mean)
+	// Output: 6 3.5
+}
+
+func ExampleAccumulator_MarshalJSON() {
+	a := NewAccumulator(1, 2, 3)
+	data, _ := json.Marshal(a)
+	fmt.Println(string(data))
+
+	var restored Accumulator[int]
+	json.Unmarshal(data, &restored)
+	restored.Add(4)
+	mean, _ := restored.Mean()
+	fmt.Println(mean)
+	// Output:
+	// {"count":3,"sum":6,"compensation":0,"mean":2,"m2":2,"min":1,"max":3}
+	// 2.5
+}
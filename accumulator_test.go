@@ -0,0 +1,177 @@
+package godino
+
+import (
+	"encoding/json"
+	"math"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccumulator(t *testing.T) {
+	t.Run("should match the statistics of the values", func(t *testing.T) {
+		data := []float64{2.75, 1.75, 1.25, 0.25, 0.5, 1.25, 3.5}
+		a := NewAccumulator(data...)
+
+		assert.Equal(t, 7, a.Count())
+		assert.InDelta(t, 11.25, a.Sum(), 1e-12)
+
+		mean, err := a.Mean()
+		assert.NoError(t, err)
+		expectedMean, _ := Mean(data...)
+		assert.InDelta(t, expectedMean, mean, 1e-12)
+
+		variance, err := a.Variance()
+		assert.NoError(t, err)
+		expectedVariance, _ := Variance(data...)
+		assert.InDelta(t, expectedVariance, variance, 1e-12)
+
+		pvariance, err := a.PVariance()
+		assert.NoError(t, err)
+		expectedPVariance, _ := PVariance(data...)
+		assert.InDelta(t, expectedPVariance, pvariance, 1e-12)
+
+		stdev, err := a.Stdev()
+		assert.NoError(t, err)
+		expectedStdev, _ := Stdev(data...)
+		assert.InDelta(t, expectedStdev, stdev, 1e-12)
+
+		pstdev, err := a.PStdev()
+		assert.NoError(t, err)
+		expectedPStdev, _ := PStdev(data...)
+		assert.InDelta(t, expectedPStdev, pstdev, 1e-12)
+
+		min, err := a.Min()
+		assert.NoError(t, err)
+		assert.Equal(t, 0.25, min)
+
+		max, err := a.Max()
+		assert.NoError(t, err)
+		assert.Equal(t, 3.5, max)
+	})
+
+	t.Run("should return errors when empty", func(t *testing.T) {
+		var a Accumulator[int]
+		_, err := a.Mean()
+		assert.Error(t, err)
+		_, err = a.Min()
+		assert.Error(t, err)
+		_, err = a.Max()
+		assert.Error(t, err)
+		_, err = a.PVariance()
+		assert.Error(t, err)
+		_, err = a.PStdev()
+		assert.Error(t, err)
+
+		a.Add(1)
+		_, err = a.Variance()
+		assert.Error(t, err)
+		_, err = a.Stdev()
+		assert.Error(t, err)
+	})
+
+	t.Run("should keep precision over large sums", func(t *testing.T) {
+		a := NewAccumulator[float64]()
+		for i := 0; i < 10; i++ {
+			a.Add(0.1)
+		}
+		assert.Equal(t, 1.0, a.Sum())
+	})
+}
+
+func TestAccumulatorMerge(t *testing.T) {
+	data := make([]int, 1000)
+	for i := range data {
+		data[i] = (i * 37) % 101
+	}
+	whole := NewAccumulator(data...)
+
+	parts := make([]*Accumulator[int], 4)
+	var wg sync.WaitGroup
+	for i := range parts {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			parts[i] = NewAccumulator(data[i*250 : (i+1)*250]...)
+		}(i)
+	}
+	wg.Wait()
+
+	merged := NewAccumulator[int]()
+	merged.Merge(Accumulator[int]{})
+	for _, p := range parts {
+		merged.Merge(*p)
+	}
+
+	assert.Equal(t, whole.Count(), merged.Count())
+	assert.Equal(t, whole.Sum(), merged.Sum())
+	wholeMean, _ := whole.Mean()
+	mergedMean, _ := merged.Mean()
+	assert.InDelta(t, wholeMean, mergedMean, 1e-9)
+	wholeVariance, _ := whole.Variance()
+	mergedVariance, _ := merged.Variance()
+	assert.InDelta(t, wholeVariance, mergedVariance, 1e-9)
+	wholeMin, _ := whole.Min()
+	mergedMin, _ := merged.Min()
+	assert.Equal(t, wholeMin, mergedMin)
+	wholeMax, _ := whole.Max()
+	mergedMax, _ := merged.Max()
+	assert.Equal(t, wholeMax, mergedMax)
+}
+
+func TestAccumulatorJSON(t *testing.T) {
+	a := NewAccumulator(3, 1, 4, 1, 5)
+	data, err := json.Marshal(a)
+	assert.NoError(t, err)
+
+	var restored Accumulator[int]
+	assert.NoError(t, json.Unmarshal(data, &restored))
+	assert.Equal(t, *a, restored)
+
+	restored.Add(9)
+	a.Add(9)
+	assert.Equal(t, *a, restored)
+
+	assert.Error(t, json.Unmarshal([]byte(`{"count": -1}`), &restored))
+	assert.Error(t, json.Unmarshal([]byte(`[]`), &restored))
+	assert.Error(t, json.Unmarshal([]byte(`{"count": 1, "sum": "1.5"}`), &restored))
+	assert.Error(t, json.Unmarshal([]byte(`{"count": 1, "sum": "foo"}`), &restored))
+}
+
+func TestAccumulatorJSONNonFinite(t *testing.T) {
+	t.Run("should round trip infinities", func(t *testing.T) {
+		a := NewAccumulator(1, math.Inf(1))
+		data, err := json.Marshal(a)
+		assert.NoError(t, err)
+		assert.Contains(t, string(data), `"max":"+Inf"`)
+
+		var restored Accumulator[float64]
+		assert.NoError(t, json.Unmarshal(data, &restored))
+		assert.True(t, math.IsInf(restored.Sum(), 1))
+		max, _ := restored.Max()
+		assert.True(t, math.IsInf(max, 1))
+		min, _ := restored.Min()
+		assert.Equal(t, 1.0, min)
+
+		a = NewAccumulator(math.Inf(-1))
+		data, err = json.Marshal(a)
+		assert.NoError(t, err)
+		assert.NoError(t, json.Unmarshal(data, &restored))
+		min, _ = restored.Min()
+		assert.True(t, math.IsInf(min, -1))
+	})
+
+	t.Run("should round trip NaN", func(t *testing.T) {
+		a := NewAccumulator(1, math.NaN(), 2)
+		data, err := json.Marshal(a)
+		assert.NoError(t, err)
+
+		var restored Accumulator[float64]
+		assert.NoError(t, json.Unmarshal(data, &restored))
+		assert.Equal(t, 3, restored.Count())
+		assert.True(t, math.IsNaN(restored.Sum()))
+		mean, _ := restored.Mean()
+		assert.True(t, math.IsNaN(mean))
+	})
+}
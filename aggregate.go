@@ -1,11 +1,19 @@
 package godino
 
 import (
+	"container/heap"
 	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
 
 	"golang.org/x/exp/constraints"
 )
 
+// Returned when the result of a floating point calculation on finite numbers is too large for the float type
+var ErrFloatOverflow error = errors.New("float overflow")
+
 type Number interface {
 	constraints.Integer | constraints.Float | constraints.Complex
 }
@@ -30,6 +38,234 @@ func Any(conditions ...bool) bool {
 	return false
 }
 
+// Returns the index of the highest value of the given arguments. If several values are equal to the
+// highest, the first index is returned. Returns an error if no arguments are given.
+func ArgMax[T constraints.Ordered](elements ...T) (int, error) {
+	if len(elements) == 0 {
+		return -1, errors.New("ArgMax() expected at least 1 argument, got 0")
+	}
+	index := 0
+	for i, e := range elements {
+		if e > elements[index] {
+			index = i
+		}
+	}
+	return index, nil
+}
+
+// Returns the index of the lowest value of the given arguments. If several values are equal to the
+// lowest, the first index is returned. Returns an error if no arguments are given.
+func ArgMin[T constraints.Ordered](elements ...T) (int, error) {
+	if len(elements) == 0 {
+		return -1, errors.New("ArgMin() expected at least 1 argument, got 0")
+	}
+	index := 0
+	for i, e := range elements {
+		if e < elements[index] {
+			index = i
+		}
+	}
+	return index, nil
+}
+
+// Returns the product of the given numbers as a big float with the given precision in bits.
+// Integers are converted exactly, so only the product is rounded.
+// Returns an error if any of the numbers is NaN, or if the product is undefined (zero times infinity).
+func BigFloatProd[T Real](prec uint, numbers ...T) (product *big.Float, err error) {
+	defer recoverNaN("BigFloatProd()", &err)
+	product = new(big.Float).SetPrec(prec).SetInt64(1)
+	for _, n := range numbers {
+		product.Mul(product, bigFloat(n))
+	}
+	return product, nil
+}
+
+// Returns the sum of the given numbers as a big float with the given precision in bits.
+// Integers are converted exactly, so only the sum is rounded.
+// Returns an error if any of the numbers is NaN, or if the sum is undefined (infinities with opposite signs).
+func BigFloatSum[T Real](prec uint, numbers ...T) (sum *big.Float, err error) {
+	defer recoverNaN("BigFloatSum()", &err)
+	sum = new(big.Float).SetPrec(prec)
+	for _, n := range numbers {
+		sum.Add(sum, bigFloat(n))
+	}
+	return sum, nil
+}
+
+// Returns the exact product of the given integers as a big integer
+func BigIntProd[T constraints.Integer](numbers ...T) *big.Int {
+	product := big.NewInt(1)
+	for _, n := range numbers {
+		product.Mul(product, bigInt(n))
+	}
+	return product
+}
+
+// Returns the exact sum of the given integers as a big integer
+func BigIntSum[T constraints.Integer](numbers ...T) *big.Int {
+	sum := big.NewInt(0)
+	for _, n := range numbers {
+		sum.Add(sum, bigInt(n))
+	}
+	return sum
+}
+
+// Returns the exact product of the given numbers as a big rational. Returns an error if any of the numbers is not finite.
+func BigRatProd[T Real](numbers ...T) (*big.Rat, error) {
+	product := big.NewRat(1, 1)
+	for _, n := range numbers {
+		r, err := bigRat(n, "BigRatProd()")
+		if err != nil {
+			return nil, err
+		}
+		product.Mul(product, r)
+	}
+	return product, nil
+}
+
+// Returns the exact sum of the given numbers as a big rational. Returns an error if any of the numbers is not finite.
+func BigRatSum[T Real](numbers ...T) (*big.Rat, error) {
+	sum := new(big.Rat)
+	for _, n := range numbers {
+		r, err := bigRat(n, "BigRatSum()")
+		if err != nil {
+			return nil, err
+		}
+		sum.Add(sum, r)
+	}
+	return sum, nil
+}
+
+func bigFloat[T Real](n T) *big.Float {
+	// Integers are converted directly as large values may not fit in a float64
+	if half := T(1) / 2; half == 0 {
+		return new(big.Float).SetInt(bigInt(n))
+	}
+	return new(big.Float).SetFloat64(float64(n))
+}
+
+func bigInt[T Real](n T) *big.Int {
+	if n < 0 {
+		return big.NewInt(int64(n))
+	}
+	return new(big.Int).SetUint64(uint64(n))
+}
+
+func bigRat[T Real](n T, call string) (*big.Rat, error) {
+	// Integers are converted directly as large values may not fit in a float64
+	if half := T(1) / 2; half == 0 {
+		return new(big.Rat).SetInt(bigInt(n)), nil
+	}
+	r := new(big.Rat).SetFloat64(float64(n))
+	if r == nil {
+		return nil, fmt.Errorf("%s cannot represent %v exactly", call, n)
+	}
+	return r, nil
+}
+
+func recoverNaN(call string, err *error) {
+	if r := recover(); r != nil {
+		nan, ok := r.(big.ErrNaN)
+		if !ok {
+			panic(r)
+		}
+		*err = fmt.Errorf("%s %s", call, nan.Error())
+	}
+}
+
+// Returns an accurate floating point sum of the given numbers, tracking multiple intermediate partial
+// sums to avoid loss of precision, like Python's math.fsum. The result is correctly rounded, even when
+// an intermediate sum is too large for a float64. Returns an infinity if the sum itself is too large,
+// see FSumChecked to detect this.
+func FSum[T Real](numbers ...T) float64 {
+	partials := []float64{}
+	special := 0.0
+	for _, n := range numbers {
+		x := float64(n)
+		if math.IsInf(x, 0) || math.IsNaN(x) {
+			special += x
+			continue
+		}
+		i := 0
+		for _, y := range partials {
+			if math.Abs(x) < math.Abs(y) {
+				x, y = y, x
+			}
+			hi := x + y
+			if math.IsInf(hi, 0) {
+				return exactFSum(numbers)
+			}
+			lo := y - (hi - x)
+			if lo != 0 {
+				partials[i] = lo
+				i++
+			}
+			x = hi
+		}
+		partials = append(partials[:i], x)
+	}
+	if special != 0 {
+		return special
+	}
+
+	// Add the partials from the largest down, stopping once the sum is exact
+	hi, lo := 0.0, 0.0
+	k := len(partials)
+	if k > 0 {
+		k--
+		hi = partials[k]
+		for k > 0 {
+			x := hi
+			y := partials[k-1]
+			k--
+			hi = x + y
+			lo = y - (hi - x)
+			if lo != 0 {
+				break
+			}
+		}
+		// Correct the rounding of hi when the remaining partials would round it the other way
+		if k > 0 && ((lo < 0 && partials[k-1] < 0) || (lo > 0 && partials[k-1] > 0)) {
+			y := lo * 2
+			x := hi + y
+			if y == x-hi {
+				hi = x
+			}
+		}
+	}
+	return hi
+}
+
+// Returns the same sum as FSum. Returns an error wrapping ErrFloatOverflow if the numbers are finite but their
+// sum is too large to represent, where Python's math.fsum raises an OverflowError.
+func FSumChecked[T Real](numbers ...T) (float64, error) {
+	sum := FSum(numbers...)
+	if math.IsInf(sum, 0) && All(Map(numbers, func(n T) bool { return !math.IsInf(float64(n), 0) })...) {
+		return sum, fmt.Errorf("FSumChecked() %w", ErrFloatOverflow)
+	}
+	return sum, nil
+}
+
+// Sums the numbers exactly with big floats, for when the partial sums used by FSum overflow
+func exactFSum[T Real](numbers []T) float64 {
+	special := 0.0
+	// Enough bits to hold any sum of float64s exactly
+	sum := new(big.Float).SetPrec(2200)
+	for _, n := range numbers {
+		x := float64(n)
+		if math.IsInf(x, 0) || math.IsNaN(x) {
+			special += x
+			continue
+		}
+		sum.Add(sum, new(big.Float).SetFloat64(x))
+	}
+	if special != 0 {
+		return special
+	}
+	f, _ := sum.Float64()
+	return f
+}
+
 // Returns the highest value of the given arguments. Returns an error if no
 // arguments are given.
 func Max[T constraints.Ordered](elements ...T) (T, error) {
@@ -47,6 +283,22 @@ func Max[T constraints.Ordered](elements ...T) (T, error) {
 	return max, err
 }
 
+// Returns the element of the array with the highest key. If several elements share the highest key,
+// the first is returned. Returns an error if the array is empty.
+func MaxBy[T any, K constraints.Ordered](arr []T, key func(T) K) (T, error) {
+	var max T
+	if len(arr) == 0 {
+		return max, errors.New("MaxBy() expected at least 1 element, got 0")
+	}
+	var maxKey K
+	for i, e := range arr {
+		if k := key(e); i == 0 || k > maxKey {
+			max, maxKey = e, k
+		}
+	}
+	return max, nil
+}
+
 // Returns the lowest value of the given arguments. Returns an error if no
 // arguments are given.
 func Min[T constraints.Ordered](elements ...T) (T, error) {
@@ -64,6 +316,130 @@ func Min[T constraints.Ordered](elements ...T) (T, error) {
 	return min, err
 }
 
+// Returns the element of the array with the lowest key. If several elements share the lowest key,
+// the first is returned. Returns an error if the array is empty.
+func MinBy[T any, K constraints.Ordered](arr []T, key func(T) K) (T, error) {
+	var min T
+	if len(arr) == 0 {
+		return min, errors.New("MinBy() expected at least 1 element, got 0")
+	}
+	var minKey K
+	for i, e := range arr {
+		if k := key(e); i == 0 || k < minKey {
+			min, minKey = e, k
+		}
+	}
+	return min, nil
+}
+
+// Returns the lowest and highest values of the given arguments in a single pass.
+// Returns an error if no arguments are given.
+func MinMax[T constraints.Ordered](elements ...T) (T, T, error) {
+	var min, max T
+	if len(elements) == 0 {
+		return min, max, errors.New("MinMax() expected at least 1 argument, got 0")
+	}
+	min, max = elements[0], elements[0]
+	for _, e := range elements[1:] {
+		if e < min {
+			min = e
+		}
+		if e > max {
+			max = e
+		}
+	}
+	return min, max, nil
+}
+
+type keyedElement[T any, K constraints.Ordered] struct {
+	key   K
+	index int
+	value T
+}
+
+// A heap of at most n keyed elements whose root is the element that would be dropped first
+type boundedHeap[T any, K constraints.Ordered] struct {
+	elements []keyedElement[T, K]
+	largest  bool
+}
+
+func (h boundedHeap[T, K]) Len() int { return len(h.elements) }
+
+func (h boundedHeap[T, K]) Less(i, j int) bool {
+	return h.dropsBefore(h.elements[i], h.elements[j])
+}
+
+// Returns true if a would be dropped from the heap before b
+func (h boundedHeap[T, K]) dropsBefore(a, b keyedElement[T, K]) bool {
+	if a.key != b.key {
+		if h.largest {
+			return a.key < b.key
+		}
+		return a.key > b.key
+	}
+	// Later elements are dropped first so ties keep their original order
+	return a.index > b.index
+}
+
+func (h boundedHeap[T, K]) Swap(i, j int) {
+	h.elements[i], h.elements[j] = h.elements[j], h.elements[i]
+}
+
+func (h *boundedHeap[T, K]) Push(x any) {
+	h.elements = append(h.elements, x.(keyedElement[T, K]))
+}
+
+func (h *boundedHeap[T, K]) Pop() any {
+	last := h.elements[len(h.elements)-1]
+	h.elements = h.elements[:len(h.elements)-1]
+	return last
+}
+
+func nBest[T any, K constraints.Ordered](n int, arr []T, key func(T) K, largest bool) []T {
+	if n <= 0 {
+		return []T{}
+	}
+	h := &boundedHeap[T, K]{largest: largest}
+	for i, v := range arr {
+		e := keyedElement[T, K]{key: key(v), index: i, value: v}
+		if h.Len() < n {
+			heap.Push(h, e)
+		} else if h.dropsBefore(h.elements[0], e) {
+			h.elements[0] = e
+			heap.Fix(h, 0)
+		}
+	}
+	result := make([]T, h.Len())
+	for i := len(result) - 1; i >= 0; i-- {
+		result[i] = heap.Pop(h).(keyedElement[T, K]).value
+	}
+	return result
+}
+
+// Returns the n largest values of the given arguments, from largest to smallest.
+// Equal values keep their original order. Uses a heap of size n, so is efficient when n is small.
+func NLargest[T constraints.Ordered](n int, elements ...T) []T {
+	return nBest(n, elements, func(e T) T { return e }, true)
+}
+
+// Returns the n elements of the array with the largest keys, from largest to smallest.
+// Elements with equal keys keep their original order. Uses a heap of size n, so is efficient when n is small.
+func NLargestBy[T any, K constraints.Ordered](n int, arr []T, key func(T) K) []T {
+	return nBest(n, arr, key, true)
+}
+
+// Returns the n smallest values of the given arguments, from smallest to largest.
+// Equal values keep their original order. Uses a heap of size n, so is efficient when n is small.
+func NSmallest[T constraints.Ordered](n int, elements ...T) []T {
+	return nBest(n, elements, func(e T) T { return e }, false)
+}
+
+// Returns the n elements of the array with the smallest keys, from smallest to largest.
+// Elements with equal keys keep their original order. Uses a heap of size n, so is efficient when n is small.
+func NSmallestBy[T any, K constraints.Ordered](n int, arr []T, key func(T) K) []T {
+	return nBest(n, arr, key, false)
+}
+
 // Multiplies the given numeric arguments and returns the product
 func Prod[T Number](numbers ...T) T {
 	product := T(1)
@@ -73,6 +449,44 @@ func Prod[T Number](numbers ...T) T {
 	return product
 }
 
+// Multiplies the given integers and returns the product. Returns an error if the product overflows the integer type.
+func ProdChecked[T constraints.Integer](numbers ...T) (T, error) {
+	product := T(1)
+	for _, n := range numbers {
+		if product == 0 || n == 0 {
+			return 0, nil
+		}
+		p := product * n
+		// Multiplying the most negative value by -1 overflows back to itself, so check for it separately
+		if p/n != product || (n < 0 && n+1 == 0 && product < 0 && product == -product) {
+			return 0, fmt.Errorf("ProdChecked() %T: %w", product, ErrOverflow)
+		}
+		product = p
+	}
+	return product, nil
+}
+
+// Returns a new array containing the elements of the array sorted by the given key, in descending
+// order if reverse is true. The sort is stable, so elements with equal keys keep their original
+// order in either direction. The key is called once per element.
+func Sorted[T any, K constraints.Ordered](arr []T, key func(T) K, reverse bool) []T {
+	keyed := make([]keyedElement[T, K], len(arr))
+	for i, v := range arr {
+		keyed[i] = keyedElement[T, K]{key: key(v), index: i, value: v}
+	}
+	sort.SliceStable(keyed, func(i, j int) bool {
+		if reverse {
+			return keyed[i].key > keyed[j].key
+		}
+		return keyed[i].key < keyed[j].key
+	})
+	sorted := make([]T, len(arr))
+	for i, e := range keyed {
+		sorted[i] = e.value
+	}
+	return sorted
+}
+
 // Adds the given numeric arguments and returns the sum
 func Sum[T Number](numbers ...T) T {
 	var sum T
@@ -81,3 +495,16 @@ func Sum[T Number](numbers ...T) T {
 	}
 	return sum
 }
+
+// Adds the given integers and returns the sum. Returns an error if the sum overflows the integer type.
+func SumChecked[T constraints.Integer](numbers ...T) (T, error) {
+	var sum T
+	for _, n := range numbers {
+		s := sum + n
+		if (n > 0 && s < sum) || (n < 0 && s > sum) {
+			return 0, fmt.Errorf("SumChecked() %T: %w", sum, ErrOverflow)
+		}
+		sum = s
+	}
+	return sum, nil
+}
@@ -2,6 +2,7 @@ package godino
 
 import (
 	"fmt"
+	"math"
 	"strings"
 )
 
@@ -21,6 +22,25 @@ func ExampleAny() {
 	// true
 }
 
+func ExampleFSum() {
+	tenths := []float64{0.1, 0.1, 0.1, 0.1, 0.1, 0.1, 0.1, 0.1, 0.1, 0.1}
+	fmt.Println(Sum(tenths...))
+	fmt.Println(FSum(tenths...))
+	// Output:
+	// 0.9999999999999999
+	// 1
+}
+
+func ExampleFSumChecked() {
+	sum, err := FSumChecked(math.MaxFloat64, math.MaxFloat64, -math.MaxFloat64)
+	fmt.Println(sum == math.MaxFloat64, err)
+	_, err = FSumChecked(math.MaxFloat64, math.MaxFloat64)
+	fmt.Println(err)
+	// Output:
+	// true <nil>
+	// FSumChecked() float overflow
+}
+
 func ExampleMax() {
 	max, err := Max(1, 23, 100, -5, 10)
 	fmt.Println(max, err)
@@ -32,6 +52,69 @@ func ExampleMax() {
 	// 0 Max() expected at least 1 argument, got 0
 }
 
+func ExampleMaxBy() {
+	words := []string{"kiwi", "banana", "fig", "cherry"}
+	longest, err := MaxBy(words, func(w string) int { return len(w) })
+	fmt.Println(longest, err)
+	// Output: banana <nil>
+}
+
+func ExampleMinBy() {
+	words := []string{"kiwi", "banana", "fig", "cherry"}
+	shortest, err := MinBy(words, func(w string) int { return len(w) })
+	fmt.Println(shortest, err)
+	// Output: fig <nil>
+}
+
+func ExampleArgMax() {
+	fmt.Println(ArgMax(3, 9, 1, 9))
+	// Output: 1 <nil>
+}
+
+func ExampleArgMin() {
+	fmt.Println(ArgMin(3, 9, 1, 9))
+	// Output: 2 <nil>
+}
+
+func ExampleMinMax() {
+	fmt.Println(MinMax(4, -2, 8, 0))
+	// Output: -2 8 <nil>
+}
+
+func ExampleNLargest() {
+	fmt.Println(NLargest(3, 5, 1, 8, 3, 9, 2))
+	// Output: [9 8 5]
+}
+
+func ExampleNLargestBy() {
+	words := []string{"kiwi", "banana", "fig", "cherry"}
+	fmt.Println(NLargestBy(2, words, func(w string) int { return len(w) }))
+	// Output: [banana cherry]
+}
+
+func ExampleNSmallest() {
+	fmt.Println(NSmallest(3, 5, 1, 8, 3, 9, 2))
+	// Output: [1 2 3]
+}
+
+func ExampleNSmallestBy() {
+	words := []string{"kiwi", "banana", "fig", "cherry"}
+	fmt.Println(NSmallestBy(2, words, func(w string) int { return len(w) }))
+	// Output: [fig kiwi]
+}
+
+func ExampleSorted() {
+	words := []string{"kiwi", "banana", "fig", "cherry"}
+	byLength := func(w string) int { return len(w) }
+	fmt.Println(Sorted(words, byLength, false))
+	fmt.Println(Sorted(words, byLength, true))
+	fmt.Println(words)
+	// Output:
+	// [fig kiwi banana cherry]
+	// [banana cherry kiwi fig]
+	// [kiwi banana fig cherry]
+}
+
 func ExampleMin() {
 	min, err := Min(1, 23, 100, -5, 10)
 	fmt.Println(min, err)
@@ -54,3 +137,51 @@ func ExampleSum() {
 	fmt.Println(sum)
 	// Output: 129
 }
+
+func ExampleSumChecked() {
+	fmt.Println(SumChecked[int8](100, 27))
+	fmt.Println(SumChecked[int8](100, 28))
+	// Output:
+	// 127 <nil>
+	// 0 SumChecked() int8: integer overflow
+}
+
+func ExampleProdChecked() {
+	fmt.Println(ProdChecked[uint8](16, 15))
+	fmt.Println(ProdChecked[uint8](16, 16))
+	// Output:
+	// 240 <nil>
+	// 0 ProdChecked() uint8: integer overflow
+}
+
+func ExampleBigIntSum() {
+	fmt.Println(BigIntSum[uint64](math.MaxUint64, 1))
+	// Output: 18446744073709551616
+}
+
+func ExampleBigIntProd() {
+	fmt.Println(BigIntProd[int64](math.MaxInt64, 2))
+	// Output: 18446744073709551614
+}
+
+func ExampleBigRatSum() {
+	fmt.Println(BigRatSum(0.5, 0.25, 3))
+	// Output: 15/4 <nil>
+}
+
+func ExampleBigRatProd() {
+	fmt.Println(BigRatProd(0.5, 0.25, 3))
+	// Output: 3/8 <nil>
+}
+
+func ExampleBigFloatSum() {
+	sum, _ := BigFloatSum(512, 1e100, 1, -1e100)
+	fmt.Println(sum)
+	// Output: 1
+}
+
+func ExampleBigFloatProd() {
+	product, _ := BigFloatProd(200, math.MaxFloat64, 4)
+	fmt.Println(product.Text('g', 10))
+	// Output: 7.190772539e+308
+}
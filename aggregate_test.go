@@ -1,6 +1,8 @@
 package godino
 
 import (
+	"math"
+	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -85,3 +87,283 @@ func TestSum(t *testing.T) {
 		assert.Equal(t, 10, sum)
 	})
 }
+
+func TestFSum(t *testing.T) {
+	t.Run("should return an exactly rounded sum", func(t *testing.T) {
+		tenths := make([]float64, 10)
+		for i := range tenths {
+			tenths[i] = 0.1
+		}
+		assert.Equal(t, 1.0, FSum(tenths...))
+		assert.NotEqual(t, 1.0, Sum(tenths...))
+
+		assert.Equal(t, 1e-100, FSum(1e100, 1e-100, -1e100))
+		assert.Equal(t, 2.0, FSum(1, 1e100, 1, -1e100))
+		assert.Equal(t, 0.0, FSum[float64]())
+	})
+
+	t.Run("should handle integers", func(t *testing.T) {
+		assert.Equal(t, 10.0, FSum(1, 2, 3, 4))
+	})
+
+	t.Run("should propagate special values", func(t *testing.T) {
+		assert.True(t, math.IsInf(FSum(1, math.Inf(1)), 1))
+		assert.True(t, math.IsNaN(FSum(math.Inf(1), math.Inf(-1))))
+		assert.True(t, math.IsNaN(FSum(1, math.NaN())))
+	})
+
+	t.Run("should round half to even correctly", func(t *testing.T) {
+		// 1 + 2**-53 + 2**-105 is just over the halfway point between 1 and the next float
+		assert.Equal(t, 1+math.Pow(2, -52), FSum(1, math.Pow(2, -53), math.Pow(2, -105)))
+		assert.Equal(t, 1.0, FSum(1, math.Pow(2, -53), -math.Pow(2, -105)))
+	})
+
+	t.Run("should sum exactly when an intermediate sum overflows", func(t *testing.T) {
+		assert.Equal(t, math.MaxFloat64, FSum(math.MaxFloat64, math.MaxFloat64, -math.MaxFloat64))
+		assert.Equal(t, 1.0, FSum(math.MaxFloat64, math.MaxFloat64, 1, -math.MaxFloat64, -math.MaxFloat64))
+		assert.True(t, math.IsInf(FSum(math.MaxFloat64, math.MaxFloat64), 1))
+		assert.True(t, math.IsInf(FSum(-math.MaxFloat64, -math.MaxFloat64), -1))
+		assert.True(t, math.IsNaN(FSum(math.MaxFloat64, math.MaxFloat64, math.NaN())))
+	})
+}
+
+func TestFSumChecked(t *testing.T) {
+	sum, err := FSumChecked(math.MaxFloat64, math.MaxFloat64, -math.MaxFloat64)
+	assert.NoError(t, err)
+	assert.Equal(t, math.MaxFloat64, sum)
+
+	_, err = FSumChecked(math.MaxFloat64, math.MaxFloat64)
+	assert.ErrorIs(t, err, ErrFloatOverflow)
+	assert.EqualError(t, err, "FSumChecked() float overflow")
+
+	sum, err = FSumChecked(1, math.Inf(1))
+	assert.NoError(t, err, "infinite inputs are not an overflow")
+	assert.True(t, math.IsInf(sum, 1))
+}
+
+type player struct {
+	name  string
+	score int
+}
+
+var players = []player{{"ann", 7}, {"bob", 9}, {"cat", 3}, {"dan", 9}, {"eve", 3}}
+
+func byScore(p player) int { return p.score }
+
+func TestMaxByMinBy(t *testing.T) {
+	t.Run("should return the first element with the highest or lowest key", func(t *testing.T) {
+		max, err := MaxBy(players, byScore)
+		assert.NoError(t, err)
+		assert.Equal(t, "bob", max.name)
+
+		min, err := MinBy(players, byScore)
+		assert.NoError(t, err)
+		assert.Equal(t, "cat", min.name)
+	})
+
+	t.Run("should return zero value and an error for an empty list", func(t *testing.T) {
+		max, err := MaxBy([]player{}, byScore)
+		assert.Equal(t, player{}, max)
+		assert.Error(t, err)
+
+		min, err := MinBy([]player{}, byScore)
+		assert.Equal(t, player{}, min)
+		assert.Error(t, err)
+	})
+}
+
+func TestArgMaxArgMin(t *testing.T) {
+	i, err := ArgMax(3, 9, 1, 9)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, i)
+
+	i, err = ArgMin(3, 1, 9, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, i)
+
+	_, err = ArgMax[int]()
+	assert.Error(t, err)
+	_, err = ArgMin[int]()
+	assert.Error(t, err)
+}
+
+func TestMinMax(t *testing.T) {
+	min, max, err := MinMax(4, -2, 8, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, -2, min)
+	assert.Equal(t, 8, max)
+
+	min, max, err = MinMax[int]()
+	assert.Equal(t, 0, min)
+	assert.Equal(t, 0, max)
+	assert.Error(t, err)
+}
+
+func TestNLargestNSmallest(t *testing.T) {
+	nums := []int{5, 1, 8, 3, 9, 2, 8}
+	assert.Equal(t, []int{9, 8, 8}, NLargest(3, nums...))
+	assert.Equal(t, []int{1, 2, 3}, NSmallest(3, nums...))
+	assert.Equal(t, []int{9, 8, 8, 5, 3, 2, 1}, NLargest(10, nums...))
+	assert.Equal(t, []int{}, NLargest(0, nums...))
+	assert.Equal(t, []int{}, NSmallest[int](2))
+
+	t.Run("ties keep their original order", func(t *testing.T) {
+		top := NLargestBy(3, players, byScore)
+		assert.Equal(t, []string{"bob", "dan", "ann"}, Map(top, func(p player) string { return p.name }))
+
+		bottom := NSmallestBy(3, players, byScore)
+		assert.Equal(t, []string{"cat", "eve", "ann"}, Map(bottom, func(p player) string { return p.name }))
+	})
+
+	t.Run("matches a full sort", func(t *testing.T) {
+		data := make([]int, 200)
+		for i := range data {
+			data[i] = (i * 7919) % 113
+		}
+		for n := 0; n <= 20; n++ {
+			assert.Equal(t, Sorted(data, func(v int) int { return v }, true)[:n], NLargest(n, data...))
+			assert.Equal(t, Sorted(data, func(v int) int { return v }, false)[:n], NSmallest(n, data...))
+		}
+	})
+}
+
+func TestSorted(t *testing.T) {
+	sorted := Sorted(players, byScore, false)
+	assert.Equal(t, []string{"cat", "eve", "ann", "bob", "dan"}, Map(sorted, func(p player) string { return p.name }))
+
+	reversed := Sorted(players, byScore, true)
+	assert.Equal(t, []string{"bob", "dan", "ann", "cat", "eve"}, Map(reversed, func(p player) string { return p.name }))
+
+	assert.Equal(t, "ann", players[0].name, "original array should not be modified")
+	assert.Equal(t, []player{}, Sorted([]player{}, byScore, false))
+}
+
+func TestSumChecked(t *testing.T) {
+	t.Run("should return the sum when it fits", func(t *testing.T) {
+		sum, err := SumChecked(1, 2, 3, 4)
+		assert.NoError(t, err)
+		assert.Equal(t, 10, sum)
+
+		sum8, err := SumChecked[int8](100, 27, -50)
+		assert.NoError(t, err)
+		assert.Equal(t, int8(77), sum8)
+	})
+
+	t.Run("should return an error on overflow", func(t *testing.T) {
+		_, err := SumChecked[int8](100, 28)
+		assert.ErrorIs(t, err, ErrOverflow)
+
+		_, err = SumChecked[int8](-100, -29)
+		assert.ErrorIs(t, err, ErrOverflow)
+
+		_, err = SumChecked[uint8](200, 56)
+		assert.ErrorIs(t, err, ErrOverflow)
+
+		_, err = SumChecked(math.MaxInt64, 1)
+		assert.ErrorIs(t, err, ErrOverflow)
+
+		_, err = SumChecked[uint64](math.MaxUint64, 1)
+		assert.ErrorIs(t, err, ErrOverflow)
+	})
+}
+
+func TestProdChecked(t *testing.T) {
+	t.Run("should return the product when it fits", func(t *testing.T) {
+		product, err := ProdChecked(2, 3, 4)
+		assert.NoError(t, err)
+		assert.Equal(t, 24, product)
+
+		product8, err := ProdChecked[int8](-128, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, int8(-128), product8)
+
+		product8, err = ProdChecked[int8](64, -2)
+		assert.NoError(t, err)
+		assert.Equal(t, int8(-128), product8)
+
+		zero, err := ProdChecked[int8](100, 100, 0)
+		assert.ErrorIs(t, err, ErrOverflow, "overflow is reported before reaching zero")
+		assert.Equal(t, int8(0), zero)
+
+		empty, err := ProdChecked[uint]()
+		assert.NoError(t, err)
+		assert.Equal(t, uint(1), empty)
+	})
+
+	t.Run("should return an error on overflow", func(t *testing.T) {
+		_, err := ProdChecked[int8](64, 2)
+		assert.ErrorIs(t, err, ErrOverflow)
+
+		_, err = ProdChecked[int8](-128, -1)
+		assert.ErrorIs(t, err, ErrOverflow)
+
+		_, err = ProdChecked[int8](-1, -128)
+		assert.ErrorIs(t, err, ErrOverflow)
+
+		_, err = ProdChecked[uint16](256, 256)
+		assert.ErrorIs(t, err, ErrOverflow)
+
+		_, err = ProdChecked(math.MaxInt64/2, 3)
+		assert.ErrorIs(t, err, ErrOverflow)
+	})
+}
+
+func TestBigAggregates(t *testing.T) {
+	t.Run("big integers", func(t *testing.T) {
+		assert.Equal(t, "18446744073709551616", BigIntSum[uint64](math.MaxUint64, 1).String())
+		assert.Equal(t, "-9223372036854775809", BigIntSum[int64](math.MinInt64, -1).String())
+		assert.Equal(t, "85070591730234615847396907784232501249", BigIntProd[int64](math.MaxInt64, math.MaxInt64).String())
+		assert.Equal(t, "0", BigIntSum[int]().String())
+		assert.Equal(t, "1", BigIntProd[int]().String())
+	})
+
+	t.Run("big rationals", func(t *testing.T) {
+		sum, err := BigRatSum(0.1, 0.2)
+		assert.NoError(t, err)
+		expected, _ := new(big.Rat).SetString("0.3")
+		assert.NotEqual(t, 0, expected.Cmp(sum), "0.1 and 0.2 are not exactly representable")
+		assert.Equal(t, 0.30000000000000004, func() float64 { f, _ := sum.Float64(); return f }())
+
+		product, err := BigRatProd(0.5, 3, 0.25)
+		assert.NoError(t, err)
+		assert.Equal(t, "3/8", product.String())
+
+		intSum, err := BigRatSum[uint64](math.MaxUint64, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, "18446744073709551616/1", intSum.String())
+
+		_, err = BigRatSum(1, math.Inf(1))
+		assert.Error(t, err)
+		_, err = BigRatProd(1, math.NaN())
+		assert.Error(t, err)
+	})
+
+	t.Run("big floats", func(t *testing.T) {
+		sum, err := BigFloatSum(512, 1e100, 1, -1e100)
+		assert.NoError(t, err)
+		assert.Equal(t, "1", sum.Text('g', 10))
+
+		product, err := BigFloatProd(200, math.MaxFloat64, 4)
+		assert.NoError(t, err)
+		assert.Equal(t, "7.190772539e+308", product.Text('g', 10))
+
+		intSum, err := BigFloatSum[int64](200, 200, 1<<62+1)
+		assert.NoError(t, err)
+		assert.Equal(t, "4611686018427388105", intSum.Text('f', 0))
+
+		intProduct, err := BigFloatProd[uint64](200, math.MaxUint64, 3)
+		assert.NoError(t, err)
+		assert.Equal(t, "55340232221128654845", intProduct.Text('f', 0))
+
+		negativeSum, err := BigFloatSum[int64](64, math.MinInt64, -1)
+		assert.NoError(t, err)
+		assert.Equal(t, "-9223372036854775809", negativeSum.Text('f', 0))
+
+		_, err = BigFloatSum(53, math.NaN())
+		assert.Error(t, err)
+		_, err = BigFloatSum(53, math.Inf(1), math.Inf(-1))
+		assert.Error(t, err)
+		_, err = BigFloatProd(53, 0, math.Inf(1))
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,36 @@
+package godino
+
+import "fmt"
+
+func ExampleCountMinSketch() {
+	sketch := NewCountMinSketch[string](0.01, 0.01)
+	for _, word := range []string{"the", "cat", "sat", "on", "the", "mat"} {
+		sketch.Add(word)
+	}
+	fmt.Println(sketch.Estimate("the"), sketch.Estimate("cat"), sketch.Estimate("dog"))
+	fmt.Println(sketch.Total())
+	// Output:
+	// 2 1 0
+	// 6
+}
+
+func ExampleSpaceSaving() {
+	tracker := NewSpaceSaving[string](2)
+	for _, page := range []string{"home", "about", "home", "blog", "home", "blog"} {
+		tracker.Add(page)
+	}
+	fmt.Println(tracker.MostCommon(-1))
+	fmt.Println(tracker.ErrorBound("blog"))
+	// Output:
+	// [{home 3} {blog 3}]
+	// 1
+}
+
+func ExampleHyperLogLog() {
+	visitors := NewHyperLogLog[string]()
+	for _, user := range []string{"ann", "bob", "ann", "cat", "bob", "ann"} {
+		visitors.Add(user)
+	}
+	fmt.Println(visitors.Count())
+	// Output: 3
+}
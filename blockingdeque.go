@@ -0,0 +1,232 @@
+package godino
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+var (
+	// Returned when pushing to a closed deque, or popping from a closed deque which has been drained
+	ErrClosed error = errors.New("deque is closed")
+	// Returned by the non-blocking pops when the deque is empty
+	ErrEmpty error = errors.New("deque is empty")
+	// Returned by the non-blocking pushes when the deque is full
+	ErrFull error = errors.New("deque is full")
+)
+
+// A deque with an optional maximum size which is safe for concurrent use, for passing work between
+// goroutines. Pushes block while the deque is full and pops block while it is empty. Use PushRight with
+// PopLeft for a first-in first-out queue, or PushRight with PopRight for a last-in first-out stack.
+//
+// Each push and pop has a Context variant which gives up when the context is done, and a Try variant
+// which never blocks. Once the deque is closed, pushes fail and pops return the remaining elements
+// before failing.
+type BlockingDeque[T any] struct {
+	mu      sync.Mutex
+	deque   *Deque[T]
+	maxSize int
+	closed  bool
+	// changed is closed and replaced whenever elements are added or removed or the deque is closed,
+	// waking every goroutine waiting on it
+	changed chan struct{}
+}
+
+// Returns a blocking deque which holds at most maxSize elements. If maxSize is 0 or less, the deque
+// is unbounded and only pops block.
+func NewBlockingDeque[T any](maxSize int) *BlockingDeque[T] {
+	if maxSize < 0 {
+		maxSize = 0
+	}
+	return &BlockingDeque[T]{
+		deque:   NewDeque[T](),
+		maxSize: maxSize,
+		changed: make(chan struct{}),
+	}
+}
+
+func (d *BlockingDeque[T]) broadcast() {
+	close(d.changed)
+	d.changed = make(chan struct{})
+}
+
+func (d *BlockingDeque[T]) full() bool {
+	return d.maxSize > 0 && d.deque.Len() >= d.maxSize
+}
+
+// Waits for the deque to change. Must be called with the lock held, which is released while waiting.
+func (d *BlockingDeque[T]) wait(ctx context.Context) error {
+	changed := d.changed
+	d.mu.Unlock()
+	defer d.mu.Lock()
+	select {
+	case <-changed:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (d *BlockingDeque[T]) push(ctx context.Context, value T, left, block bool) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for {
+		if d.closed {
+			return ErrClosed
+		}
+		if !d.full() {
+			break
+		}
+		if !block {
+			return ErrFull
+		}
+		if err := d.wait(ctx); err != nil {
+			return err
+		}
+	}
+	if left {
+		d.deque.PushLeft(value)
+	} else {
+		d.deque.PushRight(value)
+	}
+	d.broadcast()
+	return nil
+}
+
+func (d *BlockingDeque[T]) pop(ctx context.Context, left, block bool) (T, error) {
+	var value T
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for d.deque.Len() == 0 {
+		if d.closed {
+			return value, ErrClosed
+		}
+		if !block {
+			return value, ErrEmpty
+		}
+		if err := d.wait(ctx); err != nil {
+			return value, err
+		}
+	}
+	if left {
+		value = d.deque.PopLeft()
+	} else {
+		value = d.deque.PopRight()
+	}
+	d.broadcast()
+	return value, nil
+}
+
+// Closes the deque, waking every goroutine blocked on it. Subsequent pushes fail with ErrClosed, while
+// pops return any remaining elements and then fail with ErrClosed. Closing a closed deque is a noop.
+func (d *BlockingDeque[T]) Close() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if !d.closed {
+		d.closed = true
+		d.broadcast()
+	}
+}
+
+// Returns true if the deque has been closed
+func (d *BlockingDeque[T]) Closed() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.closed
+}
+
+// Returns an array containing all elements from the deque
+func (d *BlockingDeque[T]) Elements() []T {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.deque.Elements()
+}
+
+// Returns the number of elements in the deque
+func (d *BlockingDeque[T]) Len() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.deque.Len()
+}
+
+// Returns the maximum number of elements the deque can hold, or 0 if it is unbounded
+func (d *BlockingDeque[T]) MaxSize() int {
+	return d.maxSize
+}
+
+// Removes the first element (leftmost) from the deque and returns it, waiting until an element is available.
+// Returns ErrClosed if the deque is closed and empty.
+func (d *BlockingDeque[T]) PopLeft() (T, error) {
+	return d.pop(context.Background(), true, true)
+}
+
+// Removes the first element (leftmost) from the deque and returns it, waiting until an element is available
+// or the context is done. Returns ErrClosed if the deque is closed and empty, or the context's error.
+func (d *BlockingDeque[T]) PopLeftContext(ctx context.Context) (T, error) {
+	return d.pop(ctx, true, true)
+}
+
+// Removes the last element (rightmost) from the deque and returns it, waiting until an element is available.
+// Returns ErrClosed if the deque is closed and empty.
+func (d *BlockingDeque[T]) PopRight() (T, error) {
+	return d.pop(context.Background(), false, true)
+}
+
+// Removes the last element (rightmost) from the deque and returns it, waiting until an element is available
+// or the context is done. Returns ErrClosed if the deque is closed and empty, or the context's error.
+func (d *BlockingDeque[T]) PopRightContext(ctx context.Context) (T, error) {
+	return d.pop(ctx, false, true)
+}
+
+// Adds an element to the beginning of the deque, waiting until there is space.
+// Returns ErrClosed if the deque is closed.
+func (d *BlockingDeque[T]) PushLeft(value T) error {
+	return d.push(context.Background(), value, true, true)
+}
+
+// Adds an element to the beginning of the deque, waiting until there is space or the context is done.
+// Returns ErrClosed if the deque is closed, or the context's error.
+func (d *BlockingDeque[T]) PushLeftContext(ctx context.Context, value T) error {
+	return d.push(ctx, value, true, true)
+}
+
+// Adds an element to the end of the deque, waiting until there is space.
+// Returns ErrClosed if the deque is closed.
+func (d *BlockingDeque[T]) PushRight(value T) error {
+	return d.push(context.Background(), value, false, true)
+}
+
+// Adds an element to the end of the deque, waiting until there is space or the context is done.
+// Returns ErrClosed if the deque is closed, or the context's error.
+func (d *BlockingDeque[T]) PushRightContext(ctx context.Context, value T) error {
+	return d.push(ctx, value, false, true)
+}
+
+func (d *BlockingDeque[T]) String() string {
+	return fmt.Sprintf("%v", d.Elements())
+}
+
+// Removes the first element (leftmost) from the deque and returns it without waiting.
+// Returns ErrEmpty if the deque is empty, or ErrClosed if it is closed and empty.
+func (d *BlockingDeque[T]) TryPopLeft() (T, error) {
+	return d.pop(context.Background(), true, false)
+}
+
+// Removes the last element (rightmost) from the deque and returns it without waiting.
+// Returns ErrEmpty if the deque is empty, or ErrClosed if it is closed and empty.
+func (d *BlockingDeque[T]) TryPopRight() (T, error) {
+	return d.pop(context.Background(), false, false)
+}
+
+// Adds an element to the beginning of the deque without waiting.
+// Returns ErrFull if the deque is full, or ErrClosed if it is closed.
+func (d *BlockingDeque[T]) TryPushLeft(value T) error {
+	return d.push(context.Background(), value, true, false)
+}
+
+// Adds an element to the end of the deque without waiting.
+// Returns ErrFull if the deque is full, or ErrClosed if it is closed.
+func (d *BlockingDeque[T]) TryPushRight(value T) error {
+	return d.push(context.Background(), value, false, false)
+}
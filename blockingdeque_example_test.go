@@ -0,0 +1,46 @@
+package godino
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+func ExampleBlockingDeque() {
+	queue := NewBlockingDeque[int](2)
+	go func() {
+		for i := 1; i <= 5; i++ {
+			queue.PushRight(i)
+		}
+		queue.Close()
+	}()
+	for {
+		v, err := queue.PopLeft()
+		if err != nil {
+			fmt.Println(err)
+			break
+		}
+		fmt.Println(v)
+	}
+	// Output:
+	// 1
+	// 2
+	// 3
+	// 4
+	// 5
+	// deque is closed
+}
+
+func ExampleBlockingDeque_PushRightContext() {
+	stack := NewBlockingDeque[string](1)
+	stack.PushRight("a")
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	fmt.Println(stack.PushRightContext(ctx, "b"))
+	fmt.Println(stack.TryPushRight("b"))
+	fmt.Println(stack.PopRight())
+	// Output:
+	// context deadline exceeded
+	// deque is full
+	// a <nil>
+}
@@ -0,0 +1,178 @@
+package godino
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockingDeque(t *testing.T) {
+	t.Run("should behave like a deque", func(t *testing.T) {
+		d := NewBlockingDeque[int](0)
+		assert.NoError(t, d.PushRight(2))
+		assert.NoError(t, d.PushLeft(1))
+		assert.NoError(t, d.PushRight(3))
+		assert.Equal(t, []int{1, 2, 3}, d.Elements())
+		assert.Equal(t, "[1 2 3]", d.String())
+		assert.Equal(t, 3, d.Len())
+		assert.Equal(t, 0, d.MaxSize())
+		v, err := d.PopLeft()
+		assert.NoError(t, err)
+		assert.Equal(t, 1, v)
+		v, err = d.PopRight()
+		assert.NoError(t, err)
+		assert.Equal(t, 3, v)
+	})
+
+	t.Run("should not block or fail when pushing to an unbounded deque", func(t *testing.T) {
+		d := NewBlockingDeque[int](-1)
+		for i := 0; i < 100; i++ {
+			assert.NoError(t, d.TryPushRight(i))
+		}
+		assert.Equal(t, 100, d.Len())
+	})
+
+	t.Run("should fail non-blocking operations immediately", func(t *testing.T) {
+		d := NewBlockingDeque[int](1)
+		_, err := d.TryPopLeft()
+		assert.ErrorIs(t, err, ErrEmpty)
+		_, err = d.TryPopRight()
+		assert.ErrorIs(t, err, ErrEmpty)
+		assert.NoError(t, d.TryPushLeft(1))
+		assert.ErrorIs(t, d.TryPushLeft(2), ErrFull)
+		assert.ErrorIs(t, d.TryPushRight(2), ErrFull)
+		v, err := d.TryPopRight()
+		assert.NoError(t, err)
+		assert.Equal(t, 1, v)
+	})
+
+	t.Run("should give up waiting when the context is done", func(t *testing.T) {
+		d := NewBlockingDeque[int](1)
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		_, err := d.PopLeftContext(ctx)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+		assert.NoError(t, d.PushRight(1))
+		ctx, cancel = context.WithCancel(context.Background())
+		cancel()
+		assert.ErrorIs(t, d.PushRightContext(ctx, 2), context.Canceled)
+		assert.ErrorIs(t, d.PushLeftContext(ctx, 2), context.Canceled)
+		v, err := d.PopRightContext(ctx)
+		assert.NoError(t, err, "should not wait when an element is available")
+		assert.Equal(t, 1, v)
+	})
+
+	t.Run("should block pops until an element is pushed", func(t *testing.T) {
+		d := NewBlockingDeque[int](1)
+		result := make(chan int)
+		go func() {
+			v, _ := d.PopLeft()
+			result <- v
+		}()
+		time.Sleep(10 * time.Millisecond)
+		assert.NoError(t, d.PushRight(7))
+		assert.Equal(t, 7, <-result)
+	})
+
+	t.Run("should block pushes until there is space", func(t *testing.T) {
+		d := NewBlockingDeque[int](1)
+		assert.NoError(t, d.PushRight(1))
+		done := make(chan error)
+		go func() {
+			done <- d.PushLeft(2)
+		}()
+		time.Sleep(10 * time.Millisecond)
+		assert.Equal(t, 1, d.Len())
+		v, err := d.PopRight()
+		assert.NoError(t, err)
+		assert.Equal(t, 1, v)
+		assert.NoError(t, <-done)
+		assert.Equal(t, []int{2}, d.Elements())
+	})
+
+	t.Run("should wake all waiters when closed", func(t *testing.T) {
+		d := NewBlockingDeque[int](1)
+		assert.NoError(t, d.PushRight(1))
+		errs := make(chan error, 4)
+		go func() { errs <- d.PushRight(2) }()
+		go func() { errs <- d.PushLeft(2) }()
+		empty := NewBlockingDeque[int](1)
+		go func() {
+			_, err := empty.PopLeft()
+			errs <- err
+		}()
+		go func() {
+			_, err := empty.PopRight()
+			errs <- err
+		}()
+		time.Sleep(10 * time.Millisecond)
+		d.Close()
+		empty.Close()
+		empty.Close()
+		for i := 0; i < 4; i++ {
+			assert.ErrorIs(t, <-errs, ErrClosed)
+		}
+		assert.True(t, d.Closed())
+	})
+
+	t.Run("should drain remaining elements after being closed", func(t *testing.T) {
+		d := NewBlockingDeque[int](0)
+		assert.NoError(t, d.PushRight(1))
+		assert.NoError(t, d.PushRight(2))
+		d.Close()
+		assert.ErrorIs(t, d.PushRight(3), ErrClosed)
+		assert.ErrorIs(t, d.TryPushRight(3), ErrClosed)
+		v, err := d.PopLeft()
+		assert.NoError(t, err)
+		assert.Equal(t, 1, v)
+		v, err = d.TryPopLeft()
+		assert.NoError(t, err)
+		assert.Equal(t, 2, v)
+		_, err = d.PopLeft()
+		assert.ErrorIs(t, err, ErrClosed)
+		_, err = d.TryPopLeft()
+		assert.ErrorIs(t, err, ErrClosed)
+	})
+
+	t.Run("should pass every element between producers and consumers", func(t *testing.T) {
+		d := NewBlockingDeque[int](4)
+		var producers, consumers sync.WaitGroup
+		results := make(chan int, 1000)
+		for p := 0; p < 4; p++ {
+			producers.Add(1)
+			go func(p int) {
+				defer producers.Done()
+				for i := 0; i < 250; i++ {
+					assert.NoError(t, d.PushRight(p*250+i))
+				}
+			}(p)
+		}
+		for c := 0; c < 4; c++ {
+			consumers.Add(1)
+			go func() {
+				defer consumers.Done()
+				for {
+					v, err := d.PopLeft()
+					if err != nil {
+						return
+					}
+					assert.LessOrEqual(t, d.Len(), 4)
+					results <- v
+				}
+			}()
+		}
+		producers.Wait()
+		d.Close()
+		consumers.Wait()
+		close(results)
+		seen := NewSet[int]()
+		for v := range results {
+			seen.Add(v)
+		}
+		assert.Equal(t, 1000, len(seen))
+	})
+}
@@ -0,0 +1,154 @@
+package godino
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Groups several dictionaries into a single view. Lookups search each dictionary in turn, so keys in
+// earlier dictionaries shadow those in later ones, while writes and deletions only affect the first.
+// Maps may be modified directly, but should always contain at least one dictionary.
+type ChainMap[K comparable, V any] struct {
+	Maps []Dict[K, V]
+}
+
+// Returns a new chain map which searches the given dictionaries in order.
+// If no dictionaries are given, the chain map contains a single empty dictionary.
+func NewChainMap[K comparable, V any](maps ...Dict[K, V]) ChainMap[K, V] {
+	if len(maps) == 0 {
+		maps = []Dict[K, V]{{}}
+	}
+	return ChainMap[K, V]{Maps: maps}
+}
+
+// Removes all elements from the first dictionary
+func (chain ChainMap[K, V]) Clear() {
+	chain.Maps[0].Clear()
+}
+
+// Returns a new chain map containing a copy of the first dictionary followed by the remaining dictionaries
+func (chain ChainMap[K, V]) Copy() ChainMap[K, V] {
+	maps := append([]Dict[K, V]{chain.Maps[0].Copy()}, chain.Maps[1:]...)
+	return NewChainMap(maps...)
+}
+
+// Removes the given key from the first dictionary. Returns an error if the key is not present in the
+// first dictionary, even if later dictionaries contain it.
+func (chain ChainMap[K, V]) Delete(key K) error {
+	if _, ok := chain.Maps[0].Pop(key); !ok {
+		return fmt.Errorf("Delete() key %v not found in the first mapping", key)
+	}
+	return nil
+}
+
+// Returns the value associated with the given key in the first dictionary which contains it.
+// In the case the the key is not present, a fallback value is returned if provided.
+// Otherwise the zero-value for the value type is returned.
+func (chain ChainMap[K, V]) Get(key K, fallback ...V) V {
+	for _, m := range chain.Maps {
+		if value, ok := m[key]; ok {
+			return value
+		}
+	}
+	var value V
+	if len(fallback) >= 1 {
+		value = fallback[0]
+	}
+	return value
+}
+
+// Returns true if any of the dictionaries contains the given key
+func (chain ChainMap[K, V]) Has(key K) bool {
+	for _, m := range chain.Maps {
+		if m.Has(key) {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns an array of dictionary items (a struct with Key and Value fields), one for each distinct key,
+// with the value taken from the first dictionary containing the key
+func (chain ChainMap[K, V]) Items() []DictItem[K, V] {
+	return chain.ToDict().Items()
+}
+
+// Returns an array of the distinct keys present in any of the dictionaries
+func (chain ChainMap[K, V]) Keys() []K {
+	return chain.ToDict().Keys()
+}
+
+// Returns the number of distinct keys present in any of the dictionaries
+func (chain ChainMap[K, V]) Len() int {
+	return len(chain.ToDict())
+}
+
+// Returns a new chain map with the given dictionary, or a new empty dictionary, in front of the
+// current dictionaries
+func (chain ChainMap[K, V]) NewChild(m ...Dict[K, V]) ChainMap[K, V] {
+	child := Dict[K, V]{}
+	if len(m) >= 1 {
+		child = m[0]
+	}
+	return NewChainMap(append([]Dict[K, V]{child}, chain.Maps...)...)
+}
+
+// Returns a new chain map containing all but the first dictionary
+func (chain ChainMap[K, V]) Parents() ChainMap[K, V] {
+	return NewChainMap(chain.Maps[1:]...)
+}
+
+// Removes the given key from the first dictionary and returns it's associated value.
+// If the key is not present in the first dictionary, a fallback is returned if provided.
+// Otherwise a zero-value is returned.
+// Returns a second boolean value which is true if the key was present in the first dictionary.
+func (chain ChainMap[K, V]) Pop(key K, fallback ...V) (V, bool) {
+	return chain.Maps[0].Pop(key, fallback...)
+}
+
+// Removes and returns an item from the first dictionary. Returns an error if the first dictionary is empty.
+func (chain ChainMap[K, V]) PopItem() (DictItem[K, V], error) {
+	for key, value := range chain.Maps[0] {
+		delete(chain.Maps[0], key)
+		return DictItem[K, V]{Key: key, Value: value}, nil
+	}
+	return DictItem[K, V]{}, errors.New("PopItem() called on an empty first mapping")
+}
+
+// Sets the value for the given key in the first dictionary
+func (chain ChainMap[K, V]) Set(key K, value V) {
+	chain.Maps[0][key] = value
+}
+
+// Sets the value for the given key in the first dictionary if no dictionary contains the key.
+// Returns the value for the given key.
+func (chain ChainMap[K, V]) SetDefault(key K, value V) V {
+	if !chain.Has(key) {
+		chain.Set(key, value)
+	}
+	return chain.Get(key)
+}
+
+func (chain ChainMap[K, V]) String() string {
+	return fmt.Sprintf("ChainMap%v", chain.Maps)
+}
+
+// Returns a single dictionary containing every distinct key, with the value taken from the first
+// dictionary containing the key
+func (chain ChainMap[K, V]) ToDict() Dict[K, V] {
+	flat := Dict[K, V]{}
+	for i := len(chain.Maps) - 1; i >= 0; i-- {
+		flat.Update(chain.Maps[i])
+	}
+	return flat
+}
+
+// Updates the keys and values of the first dictionary from the given dictionary
+func (chain ChainMap[K, V]) Update(dict Dict[K, V]) {
+	chain.Maps[0].Update(dict)
+}
+
+// Returns an array of values, one for each distinct key, taken from the first dictionary containing the key
+func (chain ChainMap[K, V]) Values() []V {
+	return chain.ToDict().Values()
+}
@@ -0,0 +1,36 @@
+package godino
+
+import "fmt"
+
+func ExampleChainMap() {
+	defaults := Dict[string, string]{"user": "guest", "color": "green"}
+	env := Dict[string, string]{"user": "admin"}
+	config := NewChainMap(env, defaults)
+	fmt.Println(config.Get("user"), config.Get("color"))
+	fmt.Println(config.ToDict())
+	// Output:
+	// admin green
+	// map[color:green user:admin]
+}
+
+func ExampleChainMap_Delete() {
+	config := NewChainMap(Dict[string, int]{"a": 1}, Dict[string, int]{"a": 2, "b": 3})
+	fmt.Println(config.Delete("a"))
+	fmt.Println(config.Get("a"))
+	fmt.Println(config.Delete("b"))
+	// Output:
+	// <nil>
+	// 2
+	// Delete() key b not found in the first mapping
+}
+
+func ExampleChainMap_NewChild() {
+	global := NewChainMap(Dict[string, int]{"x": 1})
+	local := global.NewChild()
+	local.Set("x", 2)
+	fmt.Println(local.Get("x"), global.Get("x"), local.Parents().Get("x"))
+	fmt.Println(local)
+	// Output:
+	// 2 1 1
+	// ChainMap[map[x:2] map[x:1]]
+}
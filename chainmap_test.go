@@ -0,0 +1,116 @@
+package godino
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func getChainMap() ChainMap[string, string] {
+	flags := Dict[string, string]{"color": "red"}
+	env := Dict[string, string]{"user": "admin", "color": "blue"}
+	defaults := Dict[string, string]{"user": "guest", "color": "green", "theme": "light"}
+	return NewChainMap(flags, env, defaults)
+}
+
+func TestChainMap(t *testing.T) {
+	t.Run("should contain a single empty dictionary when none are given", func(t *testing.T) {
+		chain := NewChainMap[string, int]()
+		assert.Equal(t, []Dict[string, int]{{}}, chain.Maps)
+		chain.Set("a", 1)
+		assert.Equal(t, 1, chain.Get("a"))
+	})
+
+	t.Run("should look up keys in each dictionary in turn", func(t *testing.T) {
+		chain := getChainMap()
+		assert.Equal(t, "red", chain.Get("color"))
+		assert.Equal(t, "admin", chain.Get("user"))
+		assert.Equal(t, "light", chain.Get("theme"))
+		assert.Equal(t, "", chain.Get("font"))
+		assert.Equal(t, "serif", chain.Get("font", "serif"))
+		assert.True(t, chain.Has("theme"))
+		assert.False(t, chain.Has("font"))
+	})
+
+	t.Run("should respect shadowing across all dictionaries", func(t *testing.T) {
+		chain := getChainMap()
+		assert.Equal(t, Dict[string, string]{"color": "red", "user": "admin", "theme": "light"}, chain.ToDict())
+		assert.ElementsMatch(t, []string{"color", "user", "theme"}, chain.Keys())
+		assert.ElementsMatch(t, []string{"red", "admin", "light"}, chain.Values())
+		assert.ElementsMatch(t, []DictItem[string, string]{
+			{Key: "color", Value: "red"},
+			{Key: "user", Value: "admin"},
+			{Key: "theme", Value: "light"},
+		}, chain.Items())
+		assert.Equal(t, 3, chain.Len())
+	})
+
+	t.Run("should write to the first dictionary only", func(t *testing.T) {
+		chain := getChainMap()
+		chain.Set("theme", "dark")
+		chain.Update(Dict[string, string]{"font": "mono"})
+		assert.Equal(t, Dict[string, string]{"color": "red", "theme": "dark", "font": "mono"}, chain.Maps[0])
+		assert.Equal(t, "light", chain.Maps[2]["theme"])
+		assert.Equal(t, "admin", chain.SetDefault("user", "root"))
+		assert.Equal(t, "12", chain.SetDefault("size", "12"))
+		assert.Equal(t, "12", chain.Maps[0]["size"])
+	})
+
+	t.Run("should delete from the first dictionary only", func(t *testing.T) {
+		chain := getChainMap()
+		assert.NoError(t, chain.Delete("color"))
+		assert.Equal(t, "blue", chain.Get("color"))
+		assert.EqualError(t, chain.Delete("color"), "Delete() key color not found in the first mapping")
+		assert.Error(t, chain.Delete("user"))
+		assert.Equal(t, "admin", chain.Get("user"))
+	})
+
+	t.Run("should pop from the first dictionary only", func(t *testing.T) {
+		chain := getChainMap()
+		value, ok := chain.Pop("user", "nobody")
+		assert.False(t, ok)
+		assert.Equal(t, "nobody", value)
+		value, ok = chain.Pop("color")
+		assert.True(t, ok)
+		assert.Equal(t, "red", value)
+
+		chain.Set("color", "red")
+		item, err := chain.PopItem()
+		assert.NoError(t, err)
+		assert.Equal(t, DictItem[string, string]{Key: "color", Value: "red"}, item)
+		_, err = chain.PopItem()
+		assert.Error(t, err)
+		assert.Equal(t, "admin", chain.Get("user"))
+
+		chain.Set("a", "b")
+		chain.Clear()
+		assert.Empty(t, chain.Maps[0])
+		assert.Equal(t, "admin", chain.Get("user"))
+	})
+
+	t.Run("should create child and parent chain maps", func(t *testing.T) {
+		chain := getChainMap()
+		child := chain.NewChild()
+		assert.Len(t, child.Maps, 4)
+		child.Set("color", "black")
+		assert.Equal(t, "black", child.Get("color"))
+		assert.Equal(t, "red", chain.Get("color"))
+
+		explicit := chain.NewChild(Dict[string, string]{"theme": "dark"})
+		assert.Equal(t, "dark", explicit.Get("theme"))
+
+		parents := chain.Parents()
+		assert.Len(t, parents.Maps, 2)
+		assert.Equal(t, "blue", parents.Get("color"))
+		assert.Equal(t, []Dict[string, string]{{}}, parents.Parents().Parents().Maps)
+	})
+
+	t.Run("should copy only the first dictionary", func(t *testing.T) {
+		chain := getChainMap()
+		c := chain.Copy()
+		c.Set("color", "white")
+		c.Maps[1]["user"] = "root"
+		assert.Equal(t, "red", chain.Get("color"))
+		assert.Equal(t, "root", chain.Get("user"))
+	})
+}
@@ -0,0 +1,206 @@
+package godino
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+)
+
+var ErrOverflow error = errors.New("integer overflow")
+
+func bigToInt(x *big.Int, call string) (int, error) {
+	if x.Cmp(big.NewInt(math.MaxInt)) > 0 {
+		return 0, fmt.Errorf("%s: %w", call, ErrOverflow)
+	}
+	return int(x.Int64()), nil
+}
+
+func checkNonNegative(call string, values ...int) error {
+	for _, v := range values {
+		if v < 0 {
+			return fmt.Errorf("%s not defined for negative values", call)
+		}
+	}
+	return nil
+}
+
+// Returns n factorial as a big integer. Returns an error if n is negative.
+func BigFactorial(n int) (*big.Int, error) {
+	if err := checkNonNegative("Factorial()", n); err != nil {
+		return nil, err
+	}
+	return new(big.Int).MulRange(1, int64(n)), nil
+}
+
+// Returns n factorial. Returns an error if n is negative or the result overflows an int.
+func Factorial(n int) (int, error) {
+	f, err := BigFactorial(n)
+	if err != nil {
+		return 0, err
+	}
+	return bigToInt(f, fmt.Sprintf("Factorial(%d)", n))
+}
+
+// Returns the number of ways to choose k items from n items without repetition and with order (nPr)
+// as a big integer. Returns 0 if k is greater than n and an error if either argument is negative.
+func BigPerm(n, k int) (*big.Int, error) {
+	if err := checkNonNegative("Perm()", n, k); err != nil {
+		return nil, err
+	}
+	if k > n {
+		return big.NewInt(0), nil
+	}
+	if k == 0 {
+		return big.NewInt(1), nil
+	}
+	return new(big.Int).MulRange(int64(n-k+1), int64(n)), nil
+}
+
+// Returns the number of ways to choose k items from n items without repetition and with order (nPr).
+// Returns 0 if k is greater than n and an error if either argument is negative or the result overflows an int.
+func Perm(n, k int) (int, error) {
+	p, err := BigPerm(n, k)
+	if err != nil {
+		return 0, err
+	}
+	return bigToInt(p, fmt.Sprintf("Perm(%d, %d)", n, k))
+}
+
+// Returns the number of ways to choose k items from n items without repetition and without order (nCr)
+// as a big integer. Returns 0 if k is greater than n and an error if either argument is negative.
+func BigComb(n, k int) (*big.Int, error) {
+	if err := checkNonNegative("Comb()", n, k); err != nil {
+		return nil, err
+	}
+	if k > n {
+		return big.NewInt(0), nil
+	}
+	return new(big.Int).Binomial(int64(n), int64(k)), nil
+}
+
+// Returns the number of ways to choose k items from n items without repetition and without order (nCr).
+// Returns 0 if k is greater than n and an error if either argument is negative or the result overflows an int.
+func Comb(n, k int) (int, error) {
+	c, err := BigComb(n, k)
+	if err != nil {
+		return 0, err
+	}
+	return bigToInt(c, fmt.Sprintf("Comb(%d, %d)", n, k))
+}
+
+// Returns the number of ways to arrange items where each count is the number of indistinguishable
+// copies of an item, as a big integer. Returns an error if any count is negative.
+func BigMultinomial(counts ...int) (*big.Int, error) {
+	if err := checkNonNegative("Multinomial()", counts...); err != nil {
+		return nil, err
+	}
+	result := big.NewInt(1)
+	total := 0
+	for _, c := range counts {
+		total += c
+		result.Mul(result, new(big.Int).Binomial(int64(total), int64(c)))
+	}
+	return result, nil
+}
+
+// Returns the number of ways to arrange items where each count is the number of indistinguishable
+// copies of an item. Returns an error if any count is negative or the result overflows an int.
+func Multinomial(counts ...int) (int, error) {
+	m, err := BigMultinomial(counts...)
+	if err != nil {
+		return 0, err
+	}
+	return bigToInt(m, fmt.Sprintf("Multinomial(%v)", counts))
+}
+
+// Returns the unsigned Stirling number of the first kind, the number of permutations of n elements
+// with exactly k cycles, as a big integer. Returns an error if either argument is negative.
+func BigStirling1(n, k int) (*big.Int, error) {
+	if err := checkNonNegative("Stirling1()", n, k); err != nil {
+		return nil, err
+	}
+	if k > n {
+		return big.NewInt(0), nil
+	}
+	// row[j] holds c(i, j), built up one row at a time using c(i+1, j) = i*c(i, j) + c(i, j-1)
+	row := make([]*big.Int, k+1)
+	for j := range row {
+		row[j] = big.NewInt(0)
+	}
+	row[0].SetInt64(1)
+	for i := 0; i < n; i++ {
+		multiplier := big.NewInt(int64(i))
+		for j := k; j >= 0; j-- {
+			row[j].Mul(row[j], multiplier)
+			if j > 0 {
+				row[j].Add(row[j], row[j-1])
+			}
+		}
+	}
+	return row[k], nil
+}
+
+// Returns the unsigned Stirling number of the first kind, the number of permutations of n elements
+// with exactly k cycles. Returns an error if either argument is negative or the result overflows an int.
+func Stirling1(n, k int) (int, error) {
+	s, err := BigStirling1(n, k)
+	if err != nil {
+		return 0, err
+	}
+	return bigToInt(s, fmt.Sprintf("Stirling1(%d, %d)", n, k))
+}
+
+// Returns the Stirling number of the second kind, the number of ways to partition n elements into
+// k non-empty subsets, as a big integer. Returns an error if either argument is negative.
+func BigStirling2(n, k int) (*big.Int, error) {
+	if err := checkNonNegative("Stirling2()", n, k); err != nil {
+		return nil, err
+	}
+	if k > n {
+		return big.NewInt(0), nil
+	}
+	// row[j] holds S(i, j), built up one row at a time using S(i+1, j) = j*S(i, j) + S(i, j-1)
+	row := make([]*big.Int, k+1)
+	for j := range row {
+		row[j] = big.NewInt(0)
+	}
+	row[0].SetInt64(1)
+	for i := 0; i < n; i++ {
+		for j := k; j >= 0; j-- {
+			row[j].Mul(row[j], big.NewInt(int64(j)))
+			if j > 0 {
+				row[j].Add(row[j], row[j-1])
+			}
+		}
+	}
+	return row[k], nil
+}
+
+// Returns the Stirling number of the second kind, the number of ways to partition n elements into
+// k non-empty subsets. Returns an error if either argument is negative or the result overflows an int.
+func Stirling2(n, k int) (int, error) {
+	s, err := BigStirling2(n, k)
+	if err != nil {
+		return 0, err
+	}
+	return bigToInt(s, fmt.Sprintf("Stirling2(%d, %d)", n, k))
+}
+
+// Returns the nth Catalan number as a big integer. Returns an error if n is negative.
+func BigCatalan(n int) (*big.Int, error) {
+	if err := checkNonNegative("Catalan()", n); err != nil {
+		return nil, err
+	}
+	c := new(big.Int).Binomial(int64(2*n), int64(n))
+	return c.Quo(c, big.NewInt(int64(n+1))), nil
+}
+
+// Returns the nth Catalan number. Returns an error if n is negative or the result overflows an int.
+func Catalan(n int) (int, error) {
+	c, err := BigCatalan(n)
+	if err != nil {
+		return 0, err
+	}
+	return bigToInt(c, fmt.Sprintf("Catalan(%d)", n))
+}
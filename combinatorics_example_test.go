@@ -0,0 +1,57 @@
+package godino
+
+import "fmt"
+
+func ExampleFactorial() {
+	fmt.Println(Factorial(5))
+	fmt.Println(Factorial(21))
+	// Output:
+	// 120 <nil>
+	// 0 Factorial(21): integer overflow
+}
+
+func ExampleBigFactorial() {
+	f, _ := BigFactorial(25)
+	fmt.Println(f)
+	// Output: 15511210043330985984000000
+}
+
+func ExamplePerm() {
+	fmt.Println(Perm(5, 2))
+	// Output: 20 <nil>
+}
+
+func ExampleComb() {
+	fmt.Println(Comb(5, 2))
+	fmt.Println(Comb(-5, 2))
+	// Output:
+	// 10 <nil>
+	// 0 Comb() not defined for negative values
+}
+
+func ExampleBigComb() {
+	c, _ := BigComb(100, 50)
+	fmt.Println(c)
+	// Output: 100891344545564193334812497256
+}
+
+func ExampleMultinomial() {
+	// The number of distinct arrangements of the letters in "MISSISSIPPI"
+	fmt.Println(Multinomial(1, 4, 4, 2))
+	// Output: 34650 <nil>
+}
+
+func ExampleStirling1() {
+	fmt.Println(Stirling1(4, 2))
+	// Output: 11 <nil>
+}
+
+func ExampleStirling2() {
+	fmt.Println(Stirling2(4, 2))
+	// Output: 7 <nil>
+}
+
+func ExampleCatalan() {
+	fmt.Println(Catalan(5))
+	// Output: 42 <nil>
+}
@@ -0,0 +1,170 @@
+package godino
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFactorial(t *testing.T) {
+	t.Run("should return the factorial of small numbers", func(t *testing.T) {
+		expected := []int{1, 1, 2, 6, 24, 120}
+		for n, e := range expected {
+			f, err := Factorial(n)
+			assert.NoError(t, err)
+			assert.Equal(t, e, f)
+		}
+		f, err := Factorial(20)
+		assert.NoError(t, err)
+		assert.Equal(t, 2432902008176640000, f)
+	})
+
+	t.Run("should return an error on overflow", func(t *testing.T) {
+		_, err := Factorial(21)
+		assert.ErrorIs(t, err, ErrOverflow)
+	})
+
+	t.Run("should return an error for negative numbers", func(t *testing.T) {
+		_, err := Factorial(-1)
+		assert.Error(t, err)
+		_, err = BigFactorial(-1)
+		assert.Error(t, err)
+	})
+
+	t.Run("should return exact big results", func(t *testing.T) {
+		f, err := BigFactorial(25)
+		assert.NoError(t, err)
+		assert.Equal(t, "15511210043330985984000000", f.String())
+	})
+}
+
+func TestPerm(t *testing.T) {
+	p, err := Perm(5, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, 20, p)
+
+	p, err = Perm(5, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, p)
+
+	p, err = Perm(2, 5)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, p)
+
+	p, err = Perm(1000, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, 997002000, p)
+
+	_, err = Perm(30, 30)
+	assert.ErrorIs(t, err, ErrOverflow)
+
+	_, err = Perm(3, -1)
+	assert.Error(t, err)
+
+	bp, err := BigPerm(30, 30)
+	assert.NoError(t, err)
+	expected, _ := BigFactorial(30)
+	assert.Equal(t, 0, expected.Cmp(bp))
+}
+
+func TestComb(t *testing.T) {
+	c, err := Comb(5, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, 10, c)
+
+	c, err = Comb(2, 5)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, c)
+
+	// The intermediate products overflow an int even though the result does not
+	c, err = Comb(62, 31)
+	assert.NoError(t, err)
+	assert.Equal(t, 465428353255261088, c)
+
+	_, err = Comb(100, 50)
+	assert.ErrorIs(t, err, ErrOverflow)
+
+	bc, err := BigComb(100, 50)
+	assert.NoError(t, err)
+	assert.Equal(t, "100891344545564193334812497256", bc.String())
+}
+
+func TestMultinomial(t *testing.T) {
+	m, err := Multinomial(2, 1, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 12, m)
+
+	m, err = Multinomial()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, m)
+
+	_, err = Multinomial(1, -1)
+	assert.Error(t, err)
+
+	_, err = Multinomial(20, 20, 20)
+	assert.ErrorIs(t, err, ErrOverflow)
+
+	bm, err := BigMultinomial(20, 20, 20)
+	assert.NoError(t, err)
+	f60, _ := BigFactorial(60)
+	f20, _ := BigFactorial(20)
+	expected := new(big.Int).Quo(f60, new(big.Int).Mul(f20, new(big.Int).Mul(f20, f20)))
+	assert.Equal(t, 0, expected.Cmp(bm))
+}
+
+func TestStirling(t *testing.T) {
+	first := [][]int{
+		{1},
+		{0, 1},
+		{0, 1, 1},
+		{0, 2, 3, 1},
+		{0, 6, 11, 6, 1},
+		{0, 24, 50, 35, 10, 1},
+	}
+	second := [][]int{
+		{1},
+		{0, 1},
+		{0, 1, 1},
+		{0, 1, 3, 1},
+		{0, 1, 7, 6, 1},
+		{0, 1, 15, 25, 10, 1},
+	}
+	for n := range first {
+		for k := range first[n] {
+			s1, err := Stirling1(n, k)
+			assert.NoError(t, err)
+			assert.Equal(t, first[n][k], s1, "Stirling1(%d, %d)", n, k)
+
+			s2, err := Stirling2(n, k)
+			assert.NoError(t, err)
+			assert.Equal(t, second[n][k], s2, "Stirling2(%d, %d)", n, k)
+		}
+	}
+
+	s, err := Stirling2(3, 5)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, s)
+
+	_, err = Stirling1(-1, 0)
+	assert.Error(t, err)
+
+	_, err = Stirling1(30, 1)
+	assert.ErrorIs(t, err, ErrOverflow)
+}
+
+func TestCatalan(t *testing.T) {
+	expected := []int{1, 1, 2, 5, 14, 42, 132, 429}
+	for n, e := range expected {
+		c, err := Catalan(n)
+		assert.NoError(t, err)
+		assert.Equal(t, e, c)
+	}
+
+	_, err := Catalan(40)
+	assert.ErrorIs(t, err, ErrOverflow)
+
+	bc, err := BigCatalan(40)
+	assert.NoError(t, err)
+	assert.Equal(t, "2622127042276492108820", bc.String())
+}
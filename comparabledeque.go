@@ -0,0 +1,56 @@
+package godino
+
+// A deque of comparable elements, with additional methods which search the deque for a value
+type ComparableDeque[T comparable] struct {
+	*Deque[T]
+}
+
+// Returns a deque of comparable elements with the specified minimum capacity (defaults to 1)
+func NewComparableDeque[T comparable](capacity ...int) ComparableDeque[T] {
+	return ComparableDeque[T]{NewDeque[T](capacity...)}
+}
+
+// Returns true if the deque contains the value
+func (d ComparableDeque[T]) Contains(value T) bool {
+	return d.IndexOf(value) != -1
+}
+
+// Returns the number of elements equal to the value
+func (d ComparableDeque[T]) Count(value T) int {
+	count := 0
+	for i := 0; i < d.length; i++ {
+		if d.store[d.position(i)] == value {
+			count++
+		}
+	}
+	return count
+}
+
+// Returns the index of the first element equal to the value, or -1 if the value is not present.
+// The search can be limited to the elements between optional start and stop indices, which may be
+// negative to count back from the end of the deque.
+func (d ComparableDeque[T]) IndexOf(value T, indices ...int) int {
+	start, stop := 0, d.length
+	if len(indices) >= 1 {
+		start = d.clamp(indices[0])
+	}
+	if len(indices) >= 2 {
+		stop = d.clamp(indices[1])
+	}
+	for i := start; i < stop; i++ {
+		if d.store[d.position(i)] == value {
+			return i
+		}
+	}
+	return -1
+}
+
+// Removes the first element equal to the value. Returns true if the value was in the deque
+func (d ComparableDeque[T]) Remove(value T) bool {
+	i := d.IndexOf(value)
+	if i == -1 {
+		return false
+	}
+	d.DeleteAt(i)
+	return true
+}
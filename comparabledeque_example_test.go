@@ -0,0 +1,16 @@
+package godino
+
+import "fmt"
+
+func ExampleComparableDeque() {
+	deque := NewComparableDeque[string]()
+	deque.ExtendRight([]string{"a", "b", "a", "c"})
+	fmt.Println(deque.Count("a"))
+	fmt.Println(deque.IndexOf("a", 1))
+	deque.Remove("a")
+	fmt.Println(deque)
+	// Output:
+	// 2
+	// 2
+	// [b a c]
+}
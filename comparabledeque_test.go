@@ -0,0 +1,50 @@
+package godino
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComparableDeque(t *testing.T) {
+	getDeque := func() ComparableDeque[string] {
+		deque := NewComparableDeque[string](2)
+		deque.ExtendRight([]string{"b", "a", "c", "a"})
+		deque.PushLeft("a")
+		return deque
+	}
+
+	t.Run("should count and find values", func(t *testing.T) {
+		deque := getDeque()
+		assert.Equal(t, 3, deque.Count("a"))
+		assert.Equal(t, 0, deque.Count("z"))
+		assert.True(t, deque.Contains("c"))
+		assert.False(t, deque.Contains("z"))
+	})
+
+	t.Run("should find the index of values within optional bounds", func(t *testing.T) {
+		deque := getDeque()
+		assert.Equal(t, 0, deque.IndexOf("a"))
+		assert.Equal(t, 2, deque.IndexOf("a", 1))
+		assert.Equal(t, 4, deque.IndexOf("a", 3))
+		assert.Equal(t, -1, deque.IndexOf("a", 3, 4))
+		assert.Equal(t, 4, deque.IndexOf("a", -2))
+		assert.Equal(t, -1, deque.IndexOf("z"))
+		assert.Equal(t, -1, deque.IndexOf("a", 10))
+	})
+
+	t.Run("should remove the first occurrence of values", func(t *testing.T) {
+		deque := getDeque()
+		assert.True(t, deque.Remove("a"))
+		assert.True(t, deque.Remove("a"))
+		assert.False(t, deque.Remove("z"))
+		assert.Equal(t, []string{"b", "c", "a"}, deque.Elements())
+	})
+
+	t.Run("should wrap an existing deque", func(t *testing.T) {
+		deque := ComparableDeque[int]{NewBoundedDeque[int](2)}
+		deque.ExtendRight([]int{1, 2, 3})
+		assert.Equal(t, 1, deque.IndexOf(3))
+		assert.Equal(t, 2, deque.MaxLen())
+	})
+}
@@ -7,114 +7,397 @@ import (
 	"golang.org/x/exp/maps"
 )
 
-type counterElement[T comparable] struct {
+// An element of a counter and its count
+type CounterEntry[T comparable, W Real] struct {
 	Element T
-	Count   int
+	Count   W
 }
-type counterElements[T comparable] []counterElement[T]
 
-func (l counterElements[T]) Less(i, j int) bool {
-	switch diff := l[i].Count - l[j].Count; {
-	case diff < 0:
-		return false
-	case diff > 0:
-		return true
+// An array of counter entries, as returned by Entries, MostCommon and LeastCommon
+type CounterEntries[T comparable, W Real] []CounterEntry[T, W]
+
+// Returns an array of the counts of the entries
+func (l CounterEntries[T, W]) Counts() []W {
+	return Map(l, counterEntryCount[T, W])
+}
+
+// Returns an array of the elements of the entries
+func (l CounterEntries[T, W]) Keys() []T {
+	return Map(l, func(e CounterEntry[T, W]) T { return e.Element })
+}
+
+// Returns a dictionary mapping the element of each entry to its count
+func (l CounterEntries[T, W]) ToDict() Dict[T, W] {
+	return Dict[T, W](l.ToMap())
+}
+
+// Returns a map from the element of each entry to its count
+func (l CounterEntries[T, W]) ToMap() map[T]W {
+	m := make(map[T]W, len(l))
+	for _, e := range l {
+		m[e.Element] = e.Count
 	}
-	return i < j
+	return m
+}
+
+// Orders elements from most to least common. Used with sort.Stable so ties keep their insertion order.
+func (l CounterEntries[T, W]) Less(i, j int) bool {
+	return l[i].Count > l[j].Count
 }
 
-func (l counterElements[T]) Len() int { return len(l) }
+func counterEntryCount[T comparable, W Real](e CounterEntry[T, W]) W {
+	return e.Count
+}
+
+func (l CounterEntries[T, W]) Len() int { return len(l) }
 
-func (l counterElements[T]) Swap(i, j int) {
+func (l CounterEntries[T, W]) Swap(i, j int) {
 	l[i], l[j] = l[j], l[i]
 }
 
-// A datastructure for counting comparable elements. Elements and their counts are stored in a map as key value pairs
+// A datastructure for counting comparable elements with integer counts. See WeightedCounter for the
+// methods available on counters.
 type Counter[T comparable] struct {
-	counts     map[T]int
-	keys       []T
-	elements   counterElements[T]
-	mostCommon counterElements[T]
+	WeightedCounter[T, int]
 }
 
 // Returns a new counter containing the counts of the specified elements
 func NewCounter[T comparable](values []T) Counter[T] {
-	c := Counter[T]{counts: make(map[T]int), keys: []T{}}
-	for _, v := range values {
-		c.Add(v)
-	}
+	c := Counter[T]{NewWeightedCounter[T, int]()}
+	c.Update(values)
 	return c
 }
 
+// Returns a new counter containing the minimum of the counts in both counters.
+// Only elements with a positive count are kept.
+func (c Counter[T]) Intersection(c2 Counter[T]) Counter[T] {
+	return Counter[T]{c.WeightedCounter.Intersection(c2.WeightedCounter)}
+}
+
+// Returns true if the count of every element in the counter is less than or equal to its count in c2.
+// Missing elements are treated as having a count of zero.
+func (c Counter[T]) IsSubset(c2 Counter[T]) bool {
+	return c.WeightedCounter.IsSubset(c2.WeightedCounter)
+}
+
+// Returns true if the count of every element in the counter is greater than or equal to its count in c2.
+// Missing elements are treated as having a count of zero.
+func (c Counter[T]) IsSuperset(c2 Counter[T]) bool {
+	return c.WeightedCounter.IsSuperset(c2.WeightedCounter)
+}
+
+// Returns a new counter containing the counts of the counter minus the counts of c2.
+// Only elements with a positive count are kept.
+func (c Counter[T]) Minus(c2 Counter[T]) Counter[T] {
+	return Counter[T]{c.WeightedCounter.Minus(c2.WeightedCounter)}
+}
+
+// Returns a new counter containing the sum of the counts in both counters.
+// Only elements with a positive count are kept.
+func (c Counter[T]) Plus(c2 Counter[T]) Counter[T] {
+	return Counter[T]{c.WeightedCounter.Plus(c2.WeightedCounter)}
+}
+
+// Subtracts the counts of the elements in the provided counters from the counter.
+// Unlike Minus, counts may become zero or negative.
+func (c *Counter[T]) SubtractCounts(counters ...Counter[T]) {
+	for _, c2 := range counters {
+		c.WeightedCounter.SubtractCounts(c2.WeightedCounter)
+	}
+}
+
+// Returns a new counter containing the maximum of the counts in both counters.
+// Only elements with a positive count are kept.
+func (c Counter[T]) Union(c2 Counter[T]) Counter[T] {
+	return Counter[T]{c.WeightedCounter.Union(c2.WeightedCounter)}
+}
+
+// A counter whose counts may be any integer or float type, for totalling weights such as sizes or
+// durations. Elements and their counts are stored in a map as key value pairs, and are kept in the
+// order they were first added.
+type WeightedCounter[T comparable, W Real] struct {
+	counts map[T]W
+	// The elements in the order they were added. Deleted elements are left in place until enough have
+	// been deleted to compact the slice, and are detected by their position no longer matching.
+	keys       []T
+	positions  map[T]int
+	deleted    int
+	entries    CounterEntries[T, W]
+	mostCommon CounterEntries[T, W]
+}
+
+// Returns a new empty weighted counter
+func NewWeightedCounter[T comparable, W Real]() WeightedCounter[T, W] {
+	return WeightedCounter[T, W]{counts: make(map[T]W), keys: []T{}, positions: make(map[T]int)}
+}
+
 // Increments the count for the specified element
-func (c *Counter[T]) Add(value T) {
-	c.clearCache()
-	if _, ok := c.counts[value]; ok {
-		c.counts[value]++
-	} else {
-		c.counts[value] = 1
-		c.keys = append(c.keys, value)
+func (c *WeightedCounter[T, W]) Add(value T) {
+	c.AddN(value, 1)
+}
+
+// Adds n to the count for the specified element
+func (c *WeightedCounter[T, W]) AddN(value T, n W) {
+	c.set(value, c.counts[value]+n)
+}
+
+// Returns a new counter containing the elements of both counters for which f returns a positive count.
+// Elements are ordered as in c, followed by the elements only present in c2.
+func (c WeightedCounter[T, W]) combine(c2 WeightedCounter[T, W], f func(a, b W) W) WeightedCounter[T, W] {
+	result := NewWeightedCounter[T, W]()
+	for _, counter := range []WeightedCounter[T, W]{c, c2} {
+		counter.eachKey(func(k T) {
+			if _, ok := result.counts[k]; ok {
+				return
+			}
+			if n := f(c.counts[k], c2.counts[k]); n > 0 {
+				result.set(k, n)
+			}
+		})
+	}
+	return result
+}
+
+// Removes deleted elements from the keys
+func (c *WeightedCounter[T, W]) compact() {
+	keys := make([]T, 0, len(c.positions))
+	c.eachKey(func(k T) {
+		c.positions[k] = len(keys)
+		keys = append(keys, k)
+	})
+	c.keys = keys
+	c.deleted = 0
+}
+
+// Returns a copy of the counter which can be modified independently
+func (c WeightedCounter[T, W]) copy() WeightedCounter[T, W] {
+	counts := make(map[T]W, len(c.counts))
+	for k, v := range c.counts {
+		counts[k] = v
+	}
+	positions := make(map[T]int, len(c.positions))
+	for k, i := range c.positions {
+		positions[k] = i
+	}
+	return WeightedCounter[T, W]{counts: counts, keys: Copy(c.keys), positions: positions, deleted: c.deleted}
+}
+
+// Calls f with each element in the order they were added
+func (c WeightedCounter[T, W]) eachKey(f func(k T)) {
+	for i, k := range c.keys {
+		if c.deleted == 0 {
+			f(k)
+		} else if j, ok := c.positions[k]; ok && j == i {
+			f(k)
+		}
 	}
 }
 
-func (c *Counter[T]) clearCache() {
-	c.elements = nil
+func (c *WeightedCounter[T, W]) clearCache() {
+	c.entries = nil
 	c.mostCommon = nil
 }
 
+// Removes the specified element from the counter. Returns true if the element was present.
+func (c *WeightedCounter[T, W]) Delete(value T) bool {
+	if _, ok := c.counts[value]; !ok {
+		return false
+	}
+	c.clearCache()
+	delete(c.counts, value)
+	delete(c.positions, value)
+	c.deleted++
+	if c.deleted > len(c.keys)/2 {
+		c.compact()
+	}
+	return true
+}
+
+// Returns an iterator over the elements, repeating each as many times as its count, in the order they
+// were added. Elements with a count less than one are skipped and fractional counts are rounded down.
+// Changes to the counter are not reflected in an iterator which has already been created.
+func (c *WeightedCounter[T, W]) Elements() *Iterator[T] {
+	entries := c.Entries()
+	i := 0
+	var repeated W
+	return NewIterator(func() (T, bool) {
+		for i < len(entries) {
+			if repeated+1 <= entries[i].Count {
+				repeated++
+				return entries[i].Element, true
+			}
+			i++
+			repeated = 0
+		}
+		var zero T
+		return zero, false
+	})
+}
+
 // Returns the elements and their counts in the order they were added
-func (c *Counter[T]) Elements() counterElements[T] {
-	if c.elements == nil {
-		elements := counterElements[T]{}
-		for _, k := range c.keys {
-			elements = append(elements, counterElement[T]{
+func (c *WeightedCounter[T, W]) Entries() CounterEntries[T, W] {
+	if c.entries == nil {
+		entries := CounterEntries[T, W]{}
+		c.eachKey(func(k T) {
+			entries = append(entries, CounterEntry[T, W]{
 				Element: k,
 				Count:   c.counts[k],
 			})
-		}
-		c.elements = elements
+		})
+		c.entries = entries
 	}
-	return c.elements
+	return c.entries
 }
 
 // Returns the count of the specified element
-func (c Counter[T]) Get(value T) int {
+func (c WeightedCounter[T, W]) Get(value T) W {
 	return c.counts[value]
 }
 
-// Returns the n most common elements and their counts. If n is less than 0, returns
-// all elements sorted by most common. Elements with they same count are returned in the
-// order in which they were added.
-func (c *Counter[T]) MostCommon(n int) counterElements[T] {
-	if c.mostCommon == nil {
-		elements := c.Elements()
-		sort.Sort(elements)
-		c.mostCommon = elements
+// Returns a new counter containing the minimum of the counts in both counters.
+// Only elements with a positive count are kept.
+func (c WeightedCounter[T, W]) Intersection(c2 WeightedCounter[T, W]) WeightedCounter[T, W] {
+	return c.combine(c2, func(a, b W) W {
+		if a < b {
+			return a
+		}
+		return b
+	})
+}
+
+// Returns true if the count of every element in the counter is less than or equal to its count in c2.
+// Missing elements are treated as having a count of zero.
+func (c WeightedCounter[T, W]) IsSubset(c2 WeightedCounter[T, W]) bool {
+	subset := true
+	for _, counter := range []WeightedCounter[T, W]{c, c2} {
+		counter.eachKey(func(k T) {
+			if c.counts[k] > c2.counts[k] {
+				subset = false
+			}
+		})
+	}
+	return subset
+}
+
+// Returns true if the count of every element in the counter is greater than or equal to its count in c2.
+// Missing elements are treated as having a count of zero.
+func (c WeightedCounter[T, W]) IsSuperset(c2 WeightedCounter[T, W]) bool {
+	return c2.IsSubset(c)
+}
+
+// Returns the n least common elements and their counts, from least to most common. If n is less than 0
+// or greater than the number of elements, returns all elements. Elements with the same count are returned
+// in the order in which they were added.
+func (c *WeightedCounter[T, W]) LeastCommon(n int) CounterEntries[T, W] {
+	entries := c.Entries()
+	if n < 0 || n > len(entries) {
+		n = len(entries)
 	}
-	if n < 0 {
-		return c.mostCommon
+	return NSmallestBy(n, entries, counterEntryCount[T, W])
+}
+
+// Returns a new counter containing the counts of the counter minus the counts of c2.
+// Only elements with a positive count are kept.
+func (c WeightedCounter[T, W]) Minus(c2 WeightedCounter[T, W]) WeightedCounter[T, W] {
+	return c.combine(c2, func(a, b W) W {
+		// Avoid wrapping around when counting with unsigned weights
+		if a <= b {
+			return 0
+		}
+		return a - b
+	})
+}
+
+// Returns the n most common elements and their counts. If n is less than 0 or greater than the number
+// of elements, returns all elements sorted by most common. Elements with the same count are returned
+// in the order in which they were added. Fewer than all elements are found with a heap of size n.
+func (c *WeightedCounter[T, W]) MostCommon(n int) CounterEntries[T, W] {
+	entries := c.Entries()
+	if n < 0 || n > len(entries) {
+		n = len(entries)
+	}
+	if c.mostCommon == nil {
+		if n < len(entries) {
+			return NLargestBy(n, entries, counterEntryCount[T, W])
+		}
+		sorted := Copy(entries)
+		sort.Stable(sorted)
+		c.mostCommon = sorted
 	}
 	return c.mostCommon[:n]
 }
 
-func (c Counter[T]) String() string {
-	return fmt.Sprintf("%v", c.Elements())
+// Returns a new counter containing the sum of the counts in both counters.
+// Only elements with a positive count are kept.
+func (c WeightedCounter[T, W]) Plus(c2 WeightedCounter[T, W]) WeightedCounter[T, W] {
+	return c.combine(c2, func(a, b W) W { return a + b })
 }
 
-// Decrements the count of the specified element
-func (c *Counter[T]) Subtract(value T) {
+// Removes all elements whose count is zero or negative
+func (c *WeightedCounter[T, W]) Prune() {
+	c.clearCache()
+	c.eachKey(func(k T) {
+		if c.counts[k] <= 0 {
+			delete(c.counts, k)
+			delete(c.positions, k)
+			c.deleted++
+		}
+	})
+	c.compact()
+}
+
+// Sets the count for the specified element, recording the element if it is new
+func (c *WeightedCounter[T, W]) set(value T, n W) {
 	c.clearCache()
-	c.counts[value]--
+	if _, ok := c.counts[value]; !ok {
+		c.positions[value] = len(c.keys)
+		c.keys = append(c.keys, value)
+	}
+	c.counts[value] = n
+}
+
+// Sets the count for the specified element
+func (c *WeightedCounter[T, W]) SetCount(value T, n W) {
+	c.set(value, n)
+}
+
+func (c WeightedCounter[T, W]) String() string {
+	return fmt.Sprintf("%v", c.Entries())
+}
+
+// Decrements the count of the specified element
+func (c *WeightedCounter[T, W]) Subtract(value T) {
+	c.set(value, c.counts[value]-1)
+}
+
+// Subtracts the counts of the elements in the provided counters from the counter.
+// Unlike Minus, counts may become zero or negative.
+func (c *WeightedCounter[T, W]) SubtractCounts(counters ...WeightedCounter[T, W]) {
+	for _, c2 := range counters {
+		c2.eachKey(func(k T) {
+			c.set(k, c.counts[k]-c2.counts[k])
+		})
+	}
 }
 
 // Returns the sum of the counts all of all elements
-func (c Counter[T]) Total() int {
+func (c WeightedCounter[T, W]) Total() W {
 	return Sum(maps.Values(c.counts)...)
 }
 
+// Returns a new counter containing the maximum of the counts in both counters.
+// Only elements with a positive count are kept.
+func (c WeightedCounter[T, W]) Union(c2 WeightedCounter[T, W]) WeightedCounter[T, W] {
+	return c.combine(c2, func(a, b W) W {
+		if a > b {
+			return a
+		}
+		return b
+	})
+}
+
 // Adds the counts of the elements in the provided arrays to the counter
-func (c *Counter[T]) Update(arrs ...[]T) {
-	c.clearCache()
+func (c *WeightedCounter[T, W]) Update(arrs ...[]T) {
 	for _, arr := range arrs {
 		for _, v := range arr {
 			c.Add(v)
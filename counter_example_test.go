@@ -5,7 +5,7 @@ import "fmt"
 func ExampleNewCounter() {
 	fruits := []string{"apple", "banana", "banana", "banana", "orange", "orange"}
 	counter := NewCounter(fruits)
-	fmt.Println(counter.Elements())
+	fmt.Println(counter.Entries())
 	// Output:
 	// [{apple 1} {banana 3} {orange 2}]
 }
@@ -29,13 +29,34 @@ func ExampleCounter_Add() {
 }
 
 func ExampleCounter_Elements() {
+	counter := NewCounter([]string{"orange", "apple", "orange"})
+	counter.AddN("banana", 3)
+	fmt.Println(counter.Elements().ToArray())
+	// Output:
+	// [orange orange apple banana banana banana]
+}
+
+func ExampleCounter_Entries() {
 	fruits := []string{"apple", "banana", "banana", "banana", "orange", "orange"}
 	counter := NewCounter(fruits)
-	fmt.Println(counter.Elements())
+	fmt.Println(counter.Entries())
 	// Output:
 	// [{apple 1} {banana 3} {orange 2}]
 }
 
+func ExampleCounterEntries() {
+	fruits := []string{"apple", "banana", "banana", "banana", "orange", "orange"}
+	counter := NewCounter(fruits)
+	top := counter.MostCommon(2)
+	fmt.Println(top.Keys())
+	fmt.Println(top.Counts())
+	fmt.Println(top.ToDict().Get("orange"))
+	// Output:
+	// [banana orange]
+	// [3 2]
+	// 2
+}
+
 func ExampleCounter_Get() {
 	fruits := []string{"apple", "banana", "banana", "banana", "orange", "orange"}
 	counter := NewCounter(fruits)
@@ -46,6 +67,13 @@ func ExampleCounter_Get() {
 	// 0
 }
 
+func ExampleCounter_LeastCommon() {
+	fruits := []string{"apple", "banana", "banana", "banana", "orange", "orange", "kiwi"}
+	counter := NewCounter(fruits)
+	fmt.Println(counter.LeastCommon(2))
+	// Output: [{apple 1} {kiwi 1}]
+}
+
 func ExampleCounter_MostCommon() {
 	fruits := []string{"apple", "banana", "banana", "banana", "orange", "orange"}
 	counter := NewCounter(fruits)
@@ -93,7 +121,78 @@ func ExampleCounter_Update() {
 	evenMoreFruits := []string{"apple", "banana", "pineapple"}
 	counter.Update(moreFruits, evenMoreFruits)
 
-	fmt.Println(counter.Elements())
+	fmt.Println(counter.Entries())
 	// Output:
 	// [{apple 3} {banana 5} {orange 3} {grape 2} {pineapple 1}]
 }
+
+func ExampleCounter_Plus() {
+	morning := NewCounter([]string{"apple", "banana", "banana"})
+	evening := NewCounter([]string{"banana", "orange"})
+	fmt.Println(morning.Plus(evening))
+	fmt.Println(morning.Minus(evening))
+	fmt.Println(morning.Intersection(evening))
+	fmt.Println(morning.Union(evening))
+	// Output:
+	// [{apple 1} {banana 3} {orange 1}]
+	// [{apple 1} {banana 1}]
+	// [{banana 1}]
+	// [{apple 1} {banana 2} {orange 1}]
+}
+
+func ExampleCounter_SubtractCounts() {
+	stock := NewCounter([]string{"apple", "banana", "banana"})
+	sold := NewCounter([]string{"banana", "orange"})
+	stock.SubtractCounts(sold)
+	fmt.Println(stock)
+	// Output: [{apple 1} {banana 1} {orange -1}]
+}
+
+func ExampleCounter_IsSubset() {
+	recipe := NewCounter([]string{"egg", "egg", "flour"})
+	pantry := NewCounter([]string{"egg", "egg", "egg", "flour", "sugar"})
+	fmt.Println(recipe.IsSubset(pantry))
+	fmt.Println(recipe.IsSuperset(pantry))
+	// Output:
+	// true
+	// false
+}
+
+func ExampleCounter_AddN() {
+	counter := NewCounter([]string{"apple"})
+	counter.AddN("apple", 2)
+	counter.SetCount("banana", 5)
+	fmt.Println(counter)
+	// Output: [{apple 3} {banana 5}]
+}
+
+func ExampleCounter_Delete() {
+	counter := NewCounter([]string{"apple", "banana", "orange"})
+	counter.Delete("banana")
+	fmt.Println(counter)
+	// Output: [{apple 1} {orange 1}]
+}
+
+func ExampleCounter_Prune() {
+	counter := NewCounter([]string{"apple", "banana", "banana"})
+	counter.Subtract("apple")
+	counter.Subtract("orange")
+	fmt.Println(counter)
+	counter.Prune()
+	fmt.Println(counter)
+	// Output:
+	// [{apple 0} {banana 2} {orange -1}]
+	// [{banana 2}]
+}
+
+func ExampleWeightedCounter() {
+	downloads := NewWeightedCounter[string, float64]()
+	downloads.AddN("video.mp4", 512.5)
+	downloads.AddN("notes.txt", 0.5)
+	downloads.AddN("video.mp4", 256)
+	fmt.Println(downloads.MostCommon(1))
+	fmt.Println(downloads.Total())
+	// Output:
+	// [{video.mp4 768.5}]
+	// 769
+}
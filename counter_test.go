@@ -1,6 +1,7 @@
 package godino
 
 import (
+	"math/rand"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -8,8 +9,8 @@ import (
 
 func TestNewCounter(t *testing.T) {
 	c := NewCounter([]string{"foo", "bar", "baz", "foo", "foo", "baz"})
-	expected := counterElements[string]{{"foo", 3}, {"bar", 1}, {"baz", 2}}
-	assert.Equal(t, expected, c.Elements())
+	expected := CounterEntries[string, int]{{"foo", 3}, {"bar", 1}, {"baz", 2}}
+	assert.Equal(t, expected, c.Entries())
 }
 
 func TestCounterAdd(t *testing.T) {
@@ -28,7 +29,7 @@ func TestMostCommon(t *testing.T) {
 
 	t.Run("get all elements sorted by most common", func(t *testing.T) {
 		mostCommon := c.MostCommon(-1)
-		expected := counterElements[string]{
+		expected := CounterEntries[string, int]{
 			{"foo", 3},
 			{"baz", 2},
 			{"bar", 1},
@@ -38,7 +39,7 @@ func TestMostCommon(t *testing.T) {
 
 	t.Run("get n most common elements", func(t *testing.T) {
 		mostCommon := c.MostCommon(2)
-		expected := counterElements[string]{
+		expected := CounterEntries[string, int]{
 			{"foo", 3},
 			{"baz", 2},
 		}
@@ -48,14 +49,52 @@ func TestMostCommon(t *testing.T) {
 	t.Run("ties are broken by element first inserted", func(t *testing.T) {
 		c := NewCounter([]string{"foo", "bar", "baz", "baz"})
 		mostCommon := c.MostCommon(1)
-		expected := counterElements[string]{{"baz", 2}}
+		expected := CounterEntries[string, int]{{"baz", 2}}
 		assert.Equal(t, expected, mostCommon)
 
 		c.Add("foo")
 		mostCommon2 := c.MostCommon(1)
-		expected2 := counterElements[string]{{"foo", 2}}
+		expected2 := CounterEntries[string, int]{{"foo", 2}}
 		assert.Equal(t, expected2, mostCommon2)
 	})
+
+	t.Run("should clamp n to the number of elements", func(t *testing.T) {
+		c := NewCounter([]string{"foo", "bar", "bar"})
+		expected := CounterEntries[string, int]{{"bar", 2}, {"foo", 1}}
+		assert.Equal(t, expected, c.MostCommon(10))
+		assert.Equal(t, CounterEntries[string, int]{}, c.MostCommon(0))
+		empty := NewCounter[string](nil)
+		assert.Equal(t, CounterEntries[string, int]{}, empty.MostCommon(3))
+	})
+
+	t.Run("should keep insertion order for ties with and without the cache", func(t *testing.T) {
+		values := []int{}
+		for i := 0; i < 100; i++ {
+			for j := 0; j < i%5; j++ {
+				values = append(values, i)
+			}
+		}
+		c := NewCounter(values)
+		top := c.MostCommon(20)
+		assert.Equal(t, 20, len(top))
+		for i, e := range top {
+			assert.Equal(t, 4, e.Count)
+			assert.Equal(t, 4+5*i, e.Element)
+		}
+		all := c.MostCommon(-1)
+		assert.Equal(t, top, all[:20])
+		assert.Equal(t, top, c.MostCommon(20))
+		for i := 1; i < len(all); i++ {
+			assert.True(t, all[i-1].Count > all[i].Count || (all[i-1].Count == all[i].Count && all[i-1].Element < all[i].Element))
+		}
+	})
+
+	t.Run("does not reorder the elements", func(t *testing.T) {
+		c := NewCounter([]string{"foo", "bar", "bar"})
+		c.MostCommon(-1)
+		expected := CounterEntries[string, int]{{"foo", 1}, {"bar", 2}}
+		assert.Equal(t, expected, c.Entries())
+	})
 }
 
 func TestSubtract(t *testing.T) {
@@ -76,8 +115,235 @@ func TestUpdate(t *testing.T) {
 	arr1 := []int{2, 3, 3, 4, 5}
 	arr2 := []int{4, 5, 5, 6}
 	c.Update(arr1, arr2)
-	expected := counterElements[int]{
+	expected := CounterEntries[int, int]{
 		{1, 3}, {2, 2}, {3, 4}, {4, 2}, {5, 3}, {6, 1},
 	}
-	assert.Equal(t, expected, c.Elements())
+	assert.Equal(t, expected, c.Entries())
+}
+
+func TestCounterArithmetic(t *testing.T) {
+	c1 := NewCounter([]string{"a", "a", "a", "b", "c"})
+	c2 := NewCounter([]string{"d", "b", "b", "a"})
+	c2.Subtract("c")
+
+	t.Run("should add counts", func(t *testing.T) {
+		expected := CounterEntries[string, int]{{"a", 4}, {"b", 3}, {"d", 1}}
+		sum := c1.Plus(c2)
+		assert.Equal(t, expected, sum.Entries())
+	})
+
+	t.Run("should subtract counts keeping positive counts", func(t *testing.T) {
+		expected := CounterEntries[string, int]{{"a", 2}, {"c", 2}}
+		diff := c1.Minus(c2)
+		assert.Equal(t, expected, diff.Entries())
+		expected = CounterEntries[string, int]{{"d", 1}, {"b", 1}}
+		diff = c2.Minus(c1)
+		assert.Equal(t, expected, diff.Entries())
+	})
+
+	t.Run("should intersect counts", func(t *testing.T) {
+		expected := CounterEntries[string, int]{{"a", 1}, {"b", 1}}
+		intersection := c1.Intersection(c2)
+		assert.Equal(t, expected, intersection.Entries())
+		intersection = c1.Intersection(NewCounter[string](nil))
+		assert.Equal(t, CounterEntries[string, int]{}, intersection.Entries())
+	})
+
+	t.Run("should union counts", func(t *testing.T) {
+		expected := CounterEntries[string, int]{{"a", 3}, {"b", 2}, {"c", 1}, {"d", 1}}
+		union := c1.Union(c2)
+		assert.Equal(t, expected, union.Entries())
+	})
+
+	t.Run("should not modify the operands", func(t *testing.T) {
+		assert.Equal(t, CounterEntries[string, int]{{"a", 3}, {"b", 1}, {"c", 1}}, c1.Entries())
+		assert.Equal(t, CounterEntries[string, int]{{"d", 1}, {"b", 2}, {"a", 1}, {"c", -1}}, c2.Entries())
+	})
+}
+
+func TestSubtractCounts(t *testing.T) {
+	c := NewCounter([]int{1, 1, 2})
+	c.SubtractCounts(NewCounter([]int{1, 2, 2}), NewCounter([]int{3}))
+	expected := CounterEntries[int, int]{{1, 1}, {2, -1}, {3, -1}}
+	assert.Equal(t, expected, c.Entries())
+	assert.Equal(t, -1, c.Total())
+}
+
+func TestCounterIsSubset(t *testing.T) {
+	c1 := NewCounter([]string{"a", "b"})
+	c2 := NewCounter([]string{"a", "a", "b", "c"})
+	assert.True(t, c1.IsSubset(c2))
+	assert.False(t, c2.IsSubset(c1))
+	assert.True(t, c2.IsSuperset(c1))
+	assert.False(t, c1.IsSuperset(c2))
+	assert.True(t, c1.IsSubset(c1))
+	assert.True(t, c1.IsSuperset(c1))
+
+	t.Run("should treat missing elements as zero", func(t *testing.T) {
+		c3 := NewCounter([]string{"a"})
+		c3.Subtract("z")
+		assert.True(t, c3.IsSubset(NewCounter([]string{"a"})))
+		assert.False(t, c3.IsSuperset(NewCounter([]string{"a"})))
+		assert.True(t, NewCounter[string](nil).IsSubset(c1))
+	})
+}
+
+func TestCounterAddN(t *testing.T) {
+	c := NewCounter([]string{"foo"})
+	c.AddN("foo", 4)
+	c.AddN("bar", 2)
+	expected := CounterEntries[string, int]{{"foo", 5}, {"bar", 2}}
+	assert.Equal(t, expected, c.Entries())
+	assert.Equal(t, 7, c.Total())
+}
+
+func TestCounterSetCount(t *testing.T) {
+	c := NewCounter([]string{"foo", "bar"})
+	c.SetCount("foo", 10)
+	c.SetCount("baz", 0)
+	expected := CounterEntries[string, int]{{"foo", 10}, {"bar", 1}, {"baz", 0}}
+	assert.Equal(t, expected, c.Entries())
+	assert.Equal(t, CounterEntries[string, int]{{"foo", 10}}, c.MostCommon(1))
+}
+
+func TestCounterDelete(t *testing.T) {
+	c := NewCounter([]string{"foo", "bar", "baz", "bar"})
+	assert.Equal(t, CounterEntries[string, int]{{"bar", 2}}, c.MostCommon(1))
+	assert.True(t, c.Delete("bar"))
+	assert.False(t, c.Delete("bar"))
+	assert.Equal(t, CounterEntries[string, int]{{"foo", 1}, {"baz", 1}}, c.Entries())
+	assert.Equal(t, CounterEntries[string, int]{{"foo", 1}}, c.MostCommon(1))
+	assert.Equal(t, 0, c.Get("bar"))
+
+	c.Add("bar")
+	assert.Equal(t, CounterEntries[string, int]{{"foo", 1}, {"baz", 1}, {"bar", 1}}, c.Entries())
+}
+
+func TestCounterDeleteMany(t *testing.T) {
+	t.Run("should keep the order of elements deleted and added again", func(t *testing.T) {
+		c := NewCounter([]int{1, 2, 3, 4, 5, 6})
+		c.Delete(2)
+		c.Add(2)
+		c.Delete(1)
+		assert.Equal(t, []int{3, 4, 5, 6, 2}, c.Entries().Keys())
+		assert.True(t, c.IsSubset(NewCounter([]int{2, 3, 4, 5, 6})))
+		c.SubtractCounts(NewCounter([]int{6}))
+		c.Prune()
+		assert.Equal(t, []int{3, 4, 5, 2}, c.Entries().Keys())
+	})
+
+	t.Run("should match a reference model through random deletes", func(t *testing.T) {
+		r := rand.New(rand.NewSource(1))
+		c := NewCounter[int](nil)
+		order := []int{}
+		for i := 0; i < 2000; i++ {
+			v := r.Intn(50)
+			if r.Intn(3) == 0 {
+				j := Index(order, v)
+				assert.Equal(t, j >= 0, c.Delete(v))
+				if j >= 0 {
+					order = append(order[:j], order[j+1:]...)
+				}
+			} else {
+				if Index(order, v) < 0 {
+					order = append(order, v)
+				}
+				c.Add(v)
+			}
+			if i%100 == 0 {
+				assert.Equal(t, order, c.Entries().Keys())
+				copied := c.Plus(NewCounter[int](nil))
+				assert.Equal(t, order, copied.Entries().Keys())
+			}
+			assert.LessOrEqual(t, len(c.keys), 2*len(order)+1, "deleted keys are compacted")
+		}
+		assert.Equal(t, order, c.Entries().Keys())
+	})
+}
+
+func TestCounterPrune(t *testing.T) {
+	c := NewCounter([]string{"foo", "bar", "baz", "foo"})
+	c.Subtract("bar")
+	c.Subtract("qux")
+	c.Prune()
+	assert.Equal(t, CounterEntries[string, int]{{"foo", 2}, {"baz", 1}}, c.Entries())
+	assert.Equal(t, 3, c.Total())
+}
+
+func TestWeightedCounter(t *testing.T) {
+	t.Run("should count float weights", func(t *testing.T) {
+		c := NewWeightedCounter[string, float64]()
+		c.AddN("a", 1.5)
+		c.AddN("b", 0.25)
+		c.AddN("a", 0.5)
+		c.Add("c")
+		assert.Equal(t, 2.0, c.Get("a"))
+		assert.Equal(t, 3.25, c.Total())
+		expected := CounterEntries[string, float64]{{"a", 2}, {"c", 1}, {"b", 0.25}}
+		assert.Equal(t, expected, c.MostCommon(-1))
+	})
+
+	t.Run("should combine counters with unsigned weights", func(t *testing.T) {
+		c1 := NewWeightedCounter[string, uint]()
+		c1.SetCount("a", 3)
+		c1.SetCount("b", 1)
+		c2 := NewWeightedCounter[string, uint]()
+		c2.SetCount("b", 2)
+		diff := c1.Minus(c2)
+		assert.Equal(t, CounterEntries[string, uint]{{"a", 3}}, diff.Entries())
+		sum := c1.Plus(c2)
+		assert.Equal(t, CounterEntries[string, uint]{{"a", 3}, {"b", 3}}, sum.Entries())
+	})
+}
+
+func TestLeastCommon(t *testing.T) {
+	c := NewCounter([]string{"foo", "bar", "baz", "foo", "foo", "baz", "qux"})
+	expected := CounterEntries[string, int]{{"bar", 1}, {"qux", 1}, {"baz", 2}}
+	assert.Equal(t, expected, c.LeastCommon(3))
+	expected = CounterEntries[string, int]{{"bar", 1}, {"qux", 1}, {"baz", 2}, {"foo", 3}}
+	assert.Equal(t, expected, c.LeastCommon(-1))
+	assert.Equal(t, expected, c.LeastCommon(10))
+	assert.Equal(t, CounterEntries[string, int]{}, c.LeastCommon(0))
+}
+
+func TestCounterElements(t *testing.T) {
+	t.Run("should repeat each element by its count", func(t *testing.T) {
+		c := NewCounter([]string{"foo", "bar", "foo"})
+		c.SetCount("baz", 0)
+		c.SetCount("qux", -2)
+		c.Add("quux")
+		assert.Equal(t, []string{"foo", "foo", "bar", "quux"}, c.Elements().ToArray())
+	})
+
+	t.Run("should round fractional counts down", func(t *testing.T) {
+		c := NewWeightedCounter[string, float64]()
+		c.AddN("a", 2.5)
+		c.AddN("b", 0.5)
+		c.AddN("c", 1)
+		assert.Equal(t, []string{"a", "a", "c"}, c.Elements().ToArray())
+	})
+
+	t.Run("should not be affected by later changes", func(t *testing.T) {
+		c := NewCounter([]int{1, 2, 2})
+		it := c.Elements()
+		c.Add(1)
+		c.Delete(2)
+		assert.Equal(t, []int{1, 2, 2}, it.ToArray())
+		assert.Equal(t, []int{1, 1}, c.Elements().ToArray())
+	})
+
+	t.Run("should be empty for an empty counter", func(t *testing.T) {
+		c := NewCounter[int](nil)
+		assert.Equal(t, []int{}, c.Elements().ToArray())
+	})
+}
+
+func TestCounterEntries(t *testing.T) {
+	c := NewCounter([]string{"foo", "bar", "foo"})
+	entries := c.Entries()
+	assert.Equal(t, []string{"foo", "bar"}, entries.Keys())
+	assert.Equal(t, []int{2, 1}, entries.Counts())
+	assert.Equal(t, map[string]int{"foo": 2, "bar": 1}, entries.ToMap())
+	assert.Equal(t, Dict[string, int]{"foo": 2, "bar": 1}, entries.ToDict())
+	assert.Equal(t, []string{}, CounterEntries[string, int]{}.Keys())
 }
@@ -0,0 +1,107 @@
+package godino
+
+import (
+	"errors"
+	"math"
+)
+
+// A Count-Min Sketch estimates how many times each element has been added using a fixed amount of
+// memory, however many distinct elements there are. Estimates are never less than the true count,
+// and exceed it by at most epsilon times the total count with probability 1 - delta.
+type CountMinSketch[T comparable] struct {
+	counts [][]int
+	width  int
+	total  int
+}
+
+// Returns a new Count-Min Sketch whose estimates exceed the true counts by at most epsilon times the
+// total count, with probability 1 - delta. The sketch uses ceil(e / epsilon) * ceil(ln(1 / delta)) counters.
+// Panics if epsilon or delta is not between 0 and 1.
+func NewCountMinSketch[T comparable](epsilon, delta float64) *CountMinSketch[T] {
+	if !(epsilon > 0 && epsilon < 1) || !(delta > 0 && delta < 1) {
+		panic("NewCountMinSketch() epsilon and delta must be between 0 and 1")
+	}
+	width := int(math.Ceil(math.E / epsilon))
+	depth := int(math.Ceil(math.Log(1 / delta)))
+	counts := make([][]int, depth)
+	for i := range counts {
+		counts[i] = make([]int, width)
+	}
+	return &CountMinSketch[T]{counts: counts, width: width}
+}
+
+// Calls f with each row of the sketch and the index of the value's counter in it. The row hashes are
+// derived from two base hashes as described by Kirsch and Mitzenmacher.
+func (s *CountMinSketch[T]) each(value T, f func(row []int, i int)) {
+	h1, h2 := hashValue(value, 0), hashValue(value, 1)
+	for i, row := range s.counts {
+		f(row, int((h1+uint64(i)*h2)%uint64(s.width)))
+	}
+}
+
+// Increments the count for the specified element
+func (s *CountMinSketch[T]) Add(value T) {
+	s.AddN(value, 1)
+}
+
+// Adds n to the count for the specified element. Panics if n is negative.
+func (s *CountMinSketch[T]) AddN(value T, n int) {
+	if n < 0 {
+		panic("AddN() called with a negative count")
+	}
+	s.each(value, func(row []int, i int) {
+		row[i] += n
+	})
+	s.total += n
+}
+
+// Resets all counts to zero
+func (s *CountMinSketch[T]) Clear() {
+	for _, row := range s.counts {
+		for i := range row {
+			row[i] = 0
+		}
+	}
+	s.total = 0
+}
+
+// Returns the number of rows in the sketch, each using an independent hash function
+func (s *CountMinSketch[T]) Depth() int {
+	return len(s.counts)
+}
+
+// Returns the estimated count of the specified element, which is never less than the true count
+func (s *CountMinSketch[T]) Estimate(value T) int {
+	estimate := math.MaxInt
+	s.each(value, func(row []int, i int) {
+		if row[i] < estimate {
+			estimate = row[i]
+		}
+	})
+	return estimate
+}
+
+// Adds the counts from another sketch, as if every element added to it had been added to this sketch.
+// Returns an error if the sketches were created with different parameters.
+func (s *CountMinSketch[T]) Merge(other *CountMinSketch[T]) error {
+	if s.width != other.width || s.Depth() != other.Depth() {
+		return errors.New("Merge() sketches have different dimensions")
+	}
+	for i, row := range other.counts {
+		for j, count := range row {
+			s.counts[i][j] += count
+		}
+	}
+	s.total += other.total
+	return nil
+}
+
+// Returns the sum of the counts of all elements
+func (s *CountMinSketch[T]) Total() int {
+	return s.total
+}
+
+// Returns the number of counters in each row of the sketch
+func (s *CountMinSketch[T]) Width() int {
+	return s.width
+}
@@ -0,0 +1,76 @@
+package godino
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCountMinSketch(t *testing.T) {
+	t.Run("should size the sketch from the error and confidence", func(t *testing.T) {
+		s := NewCountMinSketch[string](0.01, 0.01)
+		assert.Equal(t, 272, s.Width())
+		assert.Equal(t, 5, s.Depth())
+	})
+
+	t.Run("should count exactly when there are few elements", func(t *testing.T) {
+		s := NewCountMinSketch[string](0.001, 0.001)
+		s.Add("foo")
+		s.Add("foo")
+		s.AddN("bar", 5)
+		assert.Equal(t, 2, s.Estimate("foo"))
+		assert.Equal(t, 5, s.Estimate("bar"))
+		assert.Equal(t, 0, s.Estimate("baz"))
+		assert.Equal(t, 7, s.Total())
+	})
+
+	t.Run("should never underestimate and stay within the error bound", func(t *testing.T) {
+		epsilon := 0.01
+		s := NewCountMinSketch[int](epsilon, 0.01)
+		counts := map[int]int{}
+		for i := 0; i < 20000; i++ {
+			v := (i * i) % 1009
+			s.Add(v)
+			counts[v]++
+		}
+		outside := 0
+		for v, count := range counts {
+			estimate := s.Estimate(v)
+			assert.GreaterOrEqual(t, estimate, count)
+			if float64(estimate-count) > epsilon*float64(s.Total()) {
+				outside++
+			}
+		}
+		assert.LessOrEqual(t, outside, len(counts)/100)
+	})
+
+	t.Run("should merge sketches", func(t *testing.T) {
+		s1 := NewCountMinSketch[string](0.01, 0.05)
+		s2 := NewCountMinSketch[string](0.01, 0.05)
+		for i := 0; i < 10; i++ {
+			s1.Add(fmt.Sprint(i))
+			s2.AddN(fmt.Sprint(i), i)
+		}
+		assert.NoError(t, s1.Merge(s2))
+		assert.Equal(t, 55, s1.Total())
+		for i := 0; i < 10; i++ {
+			assert.GreaterOrEqual(t, s1.Estimate(fmt.Sprint(i)), i+1)
+		}
+		assert.EqualError(t, s1.Merge(NewCountMinSketch[string](0.1, 0.05)), "Merge() sketches have different dimensions")
+	})
+
+	t.Run("should clear the counts", func(t *testing.T) {
+		s := NewCountMinSketch[int](0.1, 0.1)
+		s.AddN(1, 3)
+		s.Clear()
+		assert.Equal(t, 0, s.Estimate(1))
+		assert.Equal(t, 0, s.Total())
+	})
+
+	t.Run("should panic with invalid arguments", func(t *testing.T) {
+		assert.Panics(t, func() { NewCountMinSketch[int](0, 0.1) })
+		assert.Panics(t, func() { NewCountMinSketch[int](0.1, 1) })
+		assert.PanicsWithValue(t, "AddN() called with a negative count", func() { NewCountMinSketch[int](0.1, 0.1).AddN(1, -1) })
+	})
+}
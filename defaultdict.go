@@ -0,0 +1,38 @@
+package godino
+
+// A dictionary which calls a factory function to supply values for missing keys. All Dict methods are
+// available, but only Get inserts missing keys. Create default dictionaries with NewDefaultDict.
+type DefaultDict[K comparable, V any] struct {
+	Dict[K, V]
+	factory func() V
+}
+
+// Returns a new default dictionary which uses the factory to create values for missing keys.
+// If factory is nil, the zero-value for the value type is used.
+func NewDefaultDict[K comparable, V any](factory func() V) DefaultDict[K, V] {
+	return DefaultDict[K, V]{Dict: Dict[K, V]{}, factory: factory}
+}
+
+// Returns a copy of the dictionary which uses the same factory
+func (dict DefaultDict[K, V]) Copy() DefaultDict[K, V] {
+	return DefaultDict[K, V]{Dict: dict.Dict.Copy(), factory: dict.factory}
+}
+
+// Returns the value associated with the given key. If the key is not present, a value is created
+// with the factory, added to the dictionary and returned.
+// Use Dict.Get to look up a key without adding it.
+func (dict DefaultDict[K, V]) Get(key K) V {
+	value, ok := dict.Dict[key]
+	if !ok {
+		if dict.factory != nil {
+			value = dict.factory()
+		}
+		dict.Dict[key] = value
+	}
+	return value
+}
+
+// Sets the value for the given key
+func (dict DefaultDict[K, V]) Set(key K, value V) {
+	dict.Dict[key] = value
+}
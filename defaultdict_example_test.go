@@ -0,0 +1,35 @@
+package godino
+
+import "fmt"
+
+func ExampleDefaultDict() {
+	counts := NewDefaultDict[rune](func() int { return 0 })
+	for _, r := range "banana" {
+		counts.Set(r, counts.Get(r)+1)
+	}
+	fmt.Println(counts.Get('a'), counts.Get('b'), counts.Get('n'))
+	// Output: 3 1 2
+}
+
+func ExampleDefaultDict_Get() {
+	d := NewDefaultDict[string](func() []int { return []int{0} })
+	fmt.Println(d.Dict.Get("a"))
+	fmt.Println(d.Has("a"))
+	fmt.Println(d.Get("a"))
+	fmt.Println(d.Has("a"))
+	// Output:
+	// []
+	// false
+	// [0]
+	// true
+}
+
+func ExampleNewDefaultDict() {
+	nested := NewDefaultDict[string](func() DefaultDict[string, int] {
+		return NewDefaultDict[string, int](nil)
+	})
+	nested.Get("fruit").Set("apple", 3)
+	nested.Get("fruit").Set("pear", 1)
+	fmt.Println(nested.Get("fruit").Get("apple"), nested.Get("fruit").Get("pear"))
+	// Output: 3 1
+}
@@ -0,0 +1,85 @@
+package godino
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultDict(t *testing.T) {
+	t.Run("should create values for missing keys on access", func(t *testing.T) {
+		calls := 0
+		d := NewDefaultDict[string](func() int {
+			calls++
+			return 10
+		})
+		assert.Equal(t, 10, d.Get("apple"))
+		assert.True(t, d.Has("apple"))
+		d.Set("apple", 5)
+		assert.Equal(t, 5, d.Get("apple"))
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("should use the zero value when the factory is nil", func(t *testing.T) {
+		d := NewDefaultDict[string, []int](nil)
+		assert.Nil(t, d.Get("apple"))
+		assert.True(t, d.Has("apple"))
+	})
+
+	t.Run("should not add keys through the Dict methods", func(t *testing.T) {
+		d := NewDefaultDict[string](func() int { return 10 })
+		assert.Equal(t, 3, d.Dict.Get("apple", 3))
+		assert.False(t, d.Has("apple"))
+		value, ok := d.Pop("apple")
+		assert.Equal(t, 0, value)
+		assert.False(t, ok)
+		assert.Equal(t, 0, len(d.Dict))
+	})
+
+	t.Run("should support the Dict methods", func(t *testing.T) {
+		d := NewDefaultDict[string](func() int { return 0 })
+		d.Update(getDict())
+		d.Set("mango", d.Get("mango")+1)
+		assert.ElementsMatch(t, []string{"apple", "banana", "orange", "mango"}, d.Keys())
+		assert.ElementsMatch(t, []int{5, 3, 2, 1}, d.Values())
+		assert.Equal(t, 4, d.SetDefault("kiwi", 4))
+		assert.Len(t, d.Items(), 5)
+		value, ok := d.Pop("apple")
+		assert.Equal(t, 5, value)
+		assert.True(t, ok)
+		d.Clear()
+		assert.Empty(t, d.Dict)
+		assert.Equal(t, 0, d.Get("apple"))
+	})
+
+	t.Run("should group values", func(t *testing.T) {
+		groups := NewDefaultDict[int](func() []string { return []string{} })
+		for _, word := range []string{"fig", "apple", "kiwi", "pear", "plum"} {
+			groups.Set(len(word), append(groups.Get(len(word)), word))
+		}
+		assert.Equal(t, Dict[int, []string]{3: {"fig"}, 4: {"kiwi", "pear", "plum"}, 5: {"apple"}}, groups.Dict)
+	})
+
+	t.Run("should support nested default dictionaries", func(t *testing.T) {
+		d := NewDefaultDict[string](func() DefaultDict[string, int] {
+			return NewDefaultDict[string](func() int { return 0 })
+		})
+		inner := d.Get("fruit")
+		inner.Set("apple", inner.Get("apple")+1)
+		inner = d.Get("fruit")
+		inner.Set("apple", inner.Get("apple")+1)
+		d.Get("vegetable").Set("carrot", 1)
+		assert.Equal(t, 2, d.Get("fruit").Get("apple"))
+		assert.Equal(t, 1, d.Get("vegetable").Get("carrot"))
+		assert.ElementsMatch(t, []string{"fruit", "vegetable"}, d.Keys())
+	})
+
+	t.Run("should return an independent copy with the same factory", func(t *testing.T) {
+		d := NewDefaultDict[string](func() int { return 7 })
+		d.Set("apple", 1)
+		c := d.Copy()
+		assert.Equal(t, 7, c.Get("banana"))
+		assert.False(t, d.Has("banana"))
+		assert.Equal(t, 1, c.Get("apple"))
+	})
+}
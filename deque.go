@@ -11,6 +11,9 @@ type Deque[T any] struct {
 	tail        int
 	length      int
 	minCapacity int
+	// maxLen is -1 for unbounded deques
+	maxLen  int
+	onEvict func(T)
 }
 
 // Returns a deque the specified minimum capacity (defaults to 1)
@@ -24,9 +27,33 @@ func NewDeque[T any](capacity ...int) *Deque[T] {
 	return &Deque[T]{
 		store:       make([]T, cap),
 		minCapacity: cap,
+		maxLen:      -1,
 	}
 }
 
+// Returns a deque which holds at most maxLen elements. Once the deque is full, pushing to one end
+// discards an element from the opposite end. Panics if maxLen is negative.
+func NewBoundedDeque[T any](maxLen int) *Deque[T] {
+	if maxLen < 0 {
+		panic("NewBoundedDeque() maxLen must be non-negative")
+	}
+	cap := maxLen
+	if cap < 1 {
+		cap = 1
+	}
+	return &Deque[T]{
+		store:       make([]T, cap),
+		minCapacity: cap,
+		maxLen:      maxLen,
+	}
+}
+
+// Returns the element at the given index. Negative indices count back from the end of the deque.
+// Panics if the index is out of range.
+func (d *Deque[T]) At(index int) T {
+	return d.store[d.position(d.normalize("At()", index))]
+}
+
 // Returns the current capacity of the deque. This will resize automatically as elements are added or removed
 func (d Deque[T]) Capacity() int {
 	return len(d.store)
@@ -38,17 +65,68 @@ func (d *Deque[T]) Clear() {
 	d.head, d.tail, d.length = 0, 0, 0
 }
 
-// Returns a copy of the deque
+// Returns a copy of the deque. Copies of bounded deques have the same maximum length and eviction callback.
 func (d Deque[T]) Copy() *Deque[T] {
-	c := NewDeque[T](d.minCapacity)
+	var c *Deque[T]
+	if d.maxLen >= 0 {
+		c = NewBoundedDeque[T](d.maxLen)
+		c.onEvict = d.onEvict
+	} else {
+		c = NewDeque[T](d.minCapacity)
+	}
 	c.ExtendRight(d.Elements())
 	return c
 }
 
+// Removes the element at the given index and returns it. Negative indices count back from the end of the deque.
+// Panics if the index is out of range.
+func (d *Deque[T]) DeleteAt(index int) T {
+	i := d.normalize("DeleteAt()", index)
+	value := d.store[d.position(i)]
+	var dummy T
+	if i < d.length/2 {
+		// Shift the elements before the index one place to the right
+		for j := i; j > 0; j-- {
+			d.store[d.position(j)] = d.store[d.position(j-1)]
+		}
+		d.store[d.head] = dummy
+		d.head = d.increment(d.head)
+	} else {
+		// Shift the elements after the index one place to the left
+		for j := i; j < d.length-1; j++ {
+			d.store[d.position(j)] = d.store[d.position(j+1)]
+		}
+		d.tail = d.decrement(d.tail)
+		d.store[d.tail] = dummy
+	}
+	d.length--
+	d.shrinkIfSparse()
+	return value
+}
+
 func (d Deque[T]) decrement(n int) int {
 	return (n - 1 + len(d.store)) % len(d.store)
 }
 
+// Discards an element to make room for a new one. Returns false if the deque can hold no elements,
+// in which case the new element should be discarded instead.
+func (d *Deque[T]) evictIfFull(pop func() T) bool {
+	if d.maxLen < 0 || d.length < d.maxLen {
+		return true
+	}
+	if d.maxLen == 0 {
+		return false
+	}
+	d.evict(pop())
+	return true
+}
+
+func (d *Deque[T]) evict(value T) {
+	if d.onEvict != nil {
+		d.onEvict(value)
+	}
+}
+
 func (d *Deque[T]) growIfFull() {
 	capacity := len(d.store)
 	if d.length >= capacity {
@@ -66,6 +144,10 @@ func (d Deque[T]) Elements() []T {
 }
 
 func (d *Deque[T]) growIfExtendWouldMakeFull(size int) {
+	// Bounded deques never grow, they evict instead
+	if d.maxLen >= 0 {
+		return
+	}
 	capacity := len(d.store)
 	for capacity < d.length+size {
 		capacity *= 2
@@ -92,29 +174,115 @@ func (d *Deque[T]) ExtendRight(arr []T) {
 	}
 }
 
+// Adds the remaining elements of the iterator to the left side of the deque.
+// The series of left pushes results in reversing the order of given elements.
+func (d *Deque[T]) ExtendLeftIterator(it *Iterator[T]) {
+	it.Each(func(v T) bool {
+		d.PushLeft(v)
+		return true
+	})
+}
+
+// Adds the remaining elements of the iterator to the right side of the deque
+func (d *Deque[T]) ExtendRightIterator(it *Iterator[T]) {
+	it.Each(func(v T) bool {
+		d.PushRight(v)
+		return true
+	})
+}
+
 func (d Deque[T]) increment(n int) int {
 	return (n + 1) % len(d.store)
 }
 
-// Returns a slice of the queue based on the given indices.
+// Returns a slice of the queue based on the given indices. Negative indices count back from the end of the deque.
 // If one index is provided, an slice containing the single item at the index is returned.
 // If two indices are provided, a slice containging the elements between the two indicies is returned.
 func (d Deque[T]) Index(nums ...int) []T {
-	elements := d.Elements()
 	if len(nums) == 1 {
-		return []T{elements[nums[0]]}
+		return []T{d.At(nums[0])}
 	}
-	if len(nums) >= 2 {
-		return elements[nums[0]:nums[1]]
+	if len(nums) == 0 {
+		return d.Elements()
+	}
+	elements := []T{}
+	for i := d.clamp(nums[0]); i < d.clamp(nums[1]); i++ {
+		elements = append(elements, d.store[d.position(i)])
 	}
 	return elements
 }
 
+// Inserts an element at the given index, shifting the following elements one place to the right.
+// Negative indices count back from the end of the deque, and indices beyond either end insert at that end.
+// Panics if a bounded deque is full.
+func (d *Deque[T]) Insert(index int, value T) {
+	if d.maxLen >= 0 && d.length >= d.maxLen {
+		panic("Insert() called on a full bounded deque")
+	}
+	i := d.clamp(index)
+	d.growIfFull()
+	if i < d.length/2 {
+		// Shift the elements before the index one place to the left
+		d.head = d.decrement(d.head)
+		for j := 0; j < i; j++ {
+			d.store[d.position(j)] = d.store[d.position(j+1)]
+		}
+	} else {
+		// Shift the elements from the index one place to the right
+		for j := d.length; j > i; j-- {
+			d.store[d.position(j)] = d.store[d.position(j-1)]
+		}
+		d.tail = d.increment(d.tail)
+	}
+	d.store[d.position(i)] = value
+	d.length++
+}
+
 // Returns the number of elements in the deque
 func (d *Deque[T]) Len() int {
 	return d.length
 }
 
+// Converts a possibly negative index to a non-negative one. Panics if it is out of range.
+func (d Deque[T]) normalize(call string, index int) int {
+	if index < 0 {
+		index += d.length
+	}
+	if index < 0 || index >= d.length {
+		panic(fmt.Sprintf("%s index out of range", call))
+	}
+	return index
+}
+
+// Converts a possibly negative index to a non-negative one, clamped to the bounds of the deque
+func (d Deque[T]) clamp(index int) int {
+	if index < 0 {
+		index += d.length
+	}
+	if index < 0 {
+		return 0
+	}
+	if index > d.length {
+		return d.length
+	}
+	return index
+}
+
+// Returns the position in the store of the element at the given index
+func (d Deque[T]) position(index int) int {
+	return (d.head + index) % len(d.store)
+}
+
+// Returns the maximum number of elements the deque can hold, or -1 if the deque is unbounded
+func (d *Deque[T]) MaxLen() int {
+	return d.maxLen
+}
+
+// Sets a function which is called with each element a bounded deque discards to make room for a new element
+func (d *Deque[T]) OnEvict(f func(T)) {
+	d.onEvict = f
+}
+
 // Returns the first element (leftmost) in the deque.
 // Panics if called on an empty deque.
 func (d *Deque[T]) PeekLeft() T {
@@ -164,22 +332,38 @@ func (d *Deque[T]) PopRight() T {
 	return value
 }
 
-// Adds an element to the end of the deque
+// Adds an element to the end of the deque.
+// If a bounded deque is full, the first element (leftmost) is discarded.
 func (d *Deque[T]) PushRight(value T) {
+	if !d.evictIfFull(d.PopLeft) {
+		d.evict(value)
+		return
+	}
 	d.growIfFull()
 	d.store[d.tail%len(d.store)] = value
 	d.tail = d.increment(d.tail)
 	d.length++
 }
 
-// Adds an element to the beginning of the deque
+// Adds an element to the beginning of the deque.
+// If a bounded deque is full, the last element (rightmost) is discarded.
 func (d *Deque[T]) PushLeft(value T) {
+	if !d.evictIfFull(d.PopRight) {
+		d.evict(value)
+		return
+	}
 	d.growIfFull()
 	d.head = d.decrement(d.head)
 	d.store[d.head] = value
 	d.length++
 }
 
+// Sets the element at the given index. Negative indices count back from the end of the deque.
+// Panics if the index is out of range.
+func (d *Deque[T]) Set(index int, value T) {
+	d.store[d.position(d.normalize("Set()", index))] = value
+}
+
 func (d *Deque[T]) resize(size int) {
 	newStore := make([]T, size)
 	for i := 0; i <= d.length; i++ {
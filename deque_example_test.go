@@ -166,3 +166,52 @@ func ExampleDeque_Rotate() {
 	// [3 1 2]
 	// [1 2 3]
 }
+
+func ExampleNewBoundedDeque() {
+	recent := NewBoundedDeque[string](3)
+	for _, event := range []string{"login", "view", "edit", "save", "logout"} {
+		recent.PushRight(event)
+	}
+	fmt.Println(recent)
+	fmt.Println(recent.MaxLen())
+	// Output:
+	// [edit save logout]
+	// 3
+}
+
+func ExampleDeque_OnEvict() {
+	d := NewBoundedDeque[int](2)
+	d.OnEvict(func(v int) { fmt.Println("evicted", v) })
+	d.ExtendRight([]int{1, 2, 3})
+	d.PushLeft(0)
+	fmt.Println(d)
+	// Output:
+	// evicted 1
+	// evicted 3
+	// [0 2]
+}
+
+func ExampleDeque_At() {
+	deque := NewDeque[int]()
+	deque.ExtendRight([]int{1, 2, 3})
+	fmt.Println(deque.At(0), deque.At(-1))
+	deque.Set(-1, 30)
+	fmt.Println(deque)
+	// Output:
+	// 1 3
+	// [1 2 30]
+}
+
+func ExampleDeque_Insert() {
+	deque := NewDeque[int]()
+	deque.ExtendRight([]int{1, 2, 4})
+	deque.Insert(2, 3)
+	deque.Insert(-1, 10)
+	fmt.Println(deque)
+	fmt.Println(deque.DeleteAt(-2))
+	fmt.Println(deque)
+	// Output:
+	// [1 2 3 10 4]
+	// 10
+	// [1 2 3 4]
+}
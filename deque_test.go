@@ -96,6 +96,94 @@ func TestDequeIndex(t *testing.T) {
 	assert.Equal(t, []string{"Banana"}, deque.Index(1))
 	assert.Equal(t, []string{"Banana", "Cherry"}, deque.Index(1, 3))
 	assert.Equal(t, []string{"Apple", "Banana", "Cherry", "Durian"}, deque.Index(0, 4))
+
+	// Test negative and out of range indices
+	assert.Equal(t, []string{"Durian"}, deque.Index(-1))
+	assert.Equal(t, []string{"Banana", "Cherry"}, deque.Index(-3, -1))
+	assert.Equal(t, []string{"Cherry", "Durian"}, deque.Index(2, 10))
+	assert.Equal(t, []string{}, deque.Index(3, 1))
+	assert.Panics(t, func() { deque.Index(4) })
+}
+
+func TestDequeAt(t *testing.T) {
+	deque := NewDeque[int](4)
+	deque.ExtendRight([]int{2, 3, 4})
+	deque.PushLeft(1)
+
+	assert.Equal(t, 1, deque.At(0))
+	assert.Equal(t, 4, deque.At(3))
+	assert.Equal(t, 4, deque.At(-1))
+	assert.Equal(t, 1, deque.At(-4))
+	assert.PanicsWithValue(t, "At() index out of range", func() { deque.At(4) })
+	assert.Panics(t, func() { deque.At(-5) })
+
+	deque.Set(1, 20)
+	deque.Set(-1, 40)
+	assert.Equal(t, []int{1, 20, 3, 40}, deque.Elements())
+	assert.PanicsWithValue(t, "Set() index out of range", func() { deque.Set(4, 0) })
+}
+
+func TestDequeInsertAndDeleteAt(t *testing.T) {
+	t.Run("should match slice behaviour as the ring buffer wraps around", func(t *testing.T) {
+		deque := NewDeque[int]()
+		expected := []int{}
+		for i := 0; i < 200; i++ {
+			switch index := (i * 7) % (len(expected) + 3); {
+			case i%5 == 4 && len(expected) > 0:
+				index %= len(expected)
+				assert.Equal(t, expected[index], deque.DeleteAt(index))
+				expected = append(expected[:index], expected[index+1:]...)
+			case i%3 == 0:
+				deque.PushLeft(i)
+				expected = append([]int{i}, expected...)
+			default:
+				if index > len(expected) {
+					index = len(expected)
+				}
+				deque.Insert(index, i)
+				expected = append(expected[:index], append([]int{i}, expected[index:]...)...)
+			}
+			assert.Equal(t, expected, deque.Elements())
+			assert.Equal(t, len(expected), deque.Len())
+		}
+		for len(expected) > 0 {
+			index := len(expected) / 3
+			assert.Equal(t, expected[index], deque.DeleteAt(index))
+			expected = append(expected[:index], expected[index+1:]...)
+			assert.Equal(t, expected, deque.Elements())
+		}
+		assert.Equal(t, 1, deque.Capacity())
+	})
+
+	t.Run("should support negative and out of range insertion indices", func(t *testing.T) {
+		deque := NewDeque[int]()
+		deque.ExtendRight([]int{1, 2, 3})
+		deque.Insert(-1, 10)
+		deque.Insert(100, 20)
+		deque.Insert(-100, 30)
+		assert.Equal(t, []int{30, 1, 2, 10, 3, 20}, deque.Elements())
+		assert.Equal(t, 20, deque.DeleteAt(-1))
+		assert.Equal(t, 30, deque.DeleteAt(0))
+		assert.PanicsWithValue(t, "DeleteAt() index out of range", func() { deque.DeleteAt(4) })
+	})
+
+	t.Run("should not insert into a full bounded deque", func(t *testing.T) {
+		deque := NewBoundedDeque[int](3)
+		deque.ExtendRight([]int{1, 3})
+		deque.Insert(1, 2)
+		assert.Equal(t, []int{1, 2, 3}, deque.Elements())
+		assert.PanicsWithValue(t, "Insert() called on a full bounded deque", func() { deque.Insert(0, 0) })
+		deque.DeleteAt(1)
+		assert.Equal(t, []int{1, 3}, deque.Elements())
+		assert.Equal(t, 3, deque.Capacity())
+	})
+}
+
+func TestDequeExtendIterator(t *testing.T) {
+	deque := NewDeque[int]()
+	deque.ExtendRightIterator(Iter([]int{1, 2, 3}))
+	deque.ExtendLeftIterator(Iter([]int{0, -1}))
+	assert.Equal(t, []int{-1, 0, 1, 2, 3}, deque.Elements())
 }
 
 func TestResize(t *testing.T) {
@@ -110,3 +198,87 @@ func TestResize(t *testing.T) {
 	}
 	assert.Equal(t, 4, deque.Capacity())
 }
+
+func TestBoundedDeque(t *testing.T) {
+	t.Run("should discard from the opposite end when full", func(t *testing.T) {
+		deque := NewBoundedDeque[int](3)
+		deque.ExtendRight([]int{1, 2, 3, 4, 5})
+		assert.Equal(t, []int{3, 4, 5}, deque.Elements())
+		deque.PushLeft(2)
+		assert.Equal(t, []int{2, 3, 4}, deque.Elements())
+		deque.ExtendLeft([]int{1, 0})
+		assert.Equal(t, []int{0, 1, 2}, deque.Elements())
+		deque.PushRight(3)
+		assert.Equal(t, []int{1, 2, 3}, deque.Elements())
+		assert.Equal(t, 3, deque.Capacity())
+		assert.Equal(t, 3, deque.MaxLen())
+	})
+
+	t.Run("should call the eviction callback with each discarded element", func(t *testing.T) {
+		deque := NewBoundedDeque[int](2)
+		evicted := []int{}
+		deque.OnEvict(func(v int) { evicted = append(evicted, v) })
+		deque.ExtendRight([]int{1, 2, 3, 4})
+		deque.PushLeft(0)
+		assert.Equal(t, []int{1, 2, 4}, evicted)
+		assert.Equal(t, []int{0, 3}, deque.Elements())
+		deque.PopLeft()
+		deque.PushRight(5)
+		assert.Equal(t, []int{1, 2, 4}, evicted, "should not evict when there is space")
+	})
+
+	t.Run("should discard every element when the maximum length is zero", func(t *testing.T) {
+		deque := NewBoundedDeque[int](0)
+		evicted := []int{}
+		deque.OnEvict(func(v int) { evicted = append(evicted, v) })
+		deque.PushRight(1)
+		deque.PushLeft(2)
+		assert.Equal(t, 0, deque.Len())
+		assert.Equal(t, []int{1, 2}, evicted)
+	})
+
+	t.Run("should keep a fixed capacity", func(t *testing.T) {
+		deque := NewBoundedDeque[int](4)
+		for i := 0; i < 20; i++ {
+			deque.PushRight(i)
+			assert.Equal(t, 4, deque.Capacity())
+		}
+		for deque.Len() > 0 {
+			deque.PopLeft()
+			assert.Equal(t, 4, deque.Capacity())
+		}
+		deque.Clear()
+		assert.Equal(t, 4, deque.Capacity())
+	})
+
+	t.Run("should rotate and reverse a full deque", func(t *testing.T) {
+		deque := NewBoundedDeque[int](3)
+		deque.ExtendRight([]int{1, 2, 3})
+		deque.Rotate(1)
+		assert.Equal(t, []int{2, 3, 1}, deque.Elements())
+		deque.Reverse()
+		assert.Equal(t, []int{1, 3, 2}, deque.Elements())
+	})
+
+	t.Run("should copy the maximum length and callback", func(t *testing.T) {
+		deque := NewBoundedDeque[int](2)
+		evicted := 0
+		deque.OnEvict(func(int) { evicted++ })
+		deque.ExtendRight([]int{1, 2})
+		c := deque.Copy()
+		c.PushRight(3)
+		assert.Equal(t, 2, c.MaxLen())
+		assert.Equal(t, []int{2, 3}, c.Elements())
+		assert.Equal(t, 1, evicted)
+		assert.Equal(t, []int{1, 2}, deque.Elements())
+	})
+
+	t.Run("should report unbounded deques", func(t *testing.T) {
+		assert.Equal(t, -1, NewDeque[int]().MaxLen())
+		assert.Equal(t, -1, NewDeque[int]().Copy().MaxLen())
+	})
+
+	t.Run("should panic when the maximum length is negative", func(t *testing.T) {
+		assert.Panics(t, func() { NewBoundedDeque[int](-1) })
+	})
+}
@@ -0,0 +1,396 @@
+package godino
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"unsafe"
+)
+
+// Assigns each distinct value of a type a unique id, for types whose values can't be encoded directly
+type internTable[T comparable] struct {
+	mu     sync.RWMutex
+	ids    map[T]uint64
+	values []T
+}
+
+var internTables sync.Map
+
+func internTableFor[T comparable]() *internTable[T] {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	if table, ok := internTables.Load(t); ok {
+		return table.(*internTable[T])
+	}
+	table, _ := internTables.LoadOrStore(t, &internTable[T]{ids: make(map[T]uint64)})
+	return table.(*internTable[T])
+}
+
+func (table *internTable[T]) id(value T) (uint64, bool) {
+	table.mu.RLock()
+	defer table.mu.RUnlock()
+	id, ok := table.ids[value]
+	return id, ok
+}
+
+func (table *internTable[T]) intern(value T) uint64 {
+	if id, ok := table.id(value); ok {
+		return id
+	}
+	table.mu.Lock()
+	defer table.mu.Unlock()
+	if id, ok := table.ids[value]; ok {
+		return id
+	}
+	id := uint64(len(table.values))
+	table.ids[value] = id
+	table.values = append(table.values, value)
+	return id
+}
+
+func (table *internTable[T]) value(id uint64) T {
+	table.mu.RLock()
+	defer table.mu.RUnlock()
+	return table.values[id]
+}
+
+var encodableTypes sync.Map
+
+// Returns true if values of the type can be encoded from their contents, which is possible when
+// the type contains no pointers, interfaces or channels
+func encodable(t reflect.Type) bool {
+	if ok, found := encodableTypes.Load(t); found {
+		return ok.(bool)
+	}
+	ok := false
+	switch t.Kind() {
+	case reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128, reflect.String:
+		ok = true
+	case reflect.Array:
+		ok = encodable(t.Elem())
+	case reflect.Struct:
+		ok = true
+		for i := 0; i < t.NumField(); i++ {
+			if !encodable(t.Field(i).Type) {
+				ok = false
+			}
+		}
+	}
+	encodableTypes.Store(t, ok)
+	return ok
+}
+
+// Returns bits of the float which sort in the same order as the float, with -0 treated as 0 and every
+// NaN given the same bits
+func orderedFloatBits(f float64) uint64 {
+	if f == 0 {
+		f = 0
+	}
+	if f != f {
+		f = math.NaN()
+	}
+	bits := math.Float64bits(f)
+	if bits>>63 == 1 {
+		return ^bits
+	}
+	return bits | 1<<63
+}
+
+func floatFromOrderedBits(bits uint64) float64 {
+	if bits>>63 == 1 {
+		return math.Float64frombits(bits &^ (1 << 63))
+	}
+	return math.Float64frombits(^bits)
+}
+
+// Appends an encoding of the value which is equal for values equal under == and sorts numbers and
+// strings in ascending order. Blank struct fields are skipped, as they are ignored by ==.
+func encodeValue(buf []byte, v reflect.Value) []byte {
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			return append(buf, 1)
+		}
+		return append(buf, 0)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return binary.BigEndian.AppendUint64(buf, uint64(v.Int())^1<<63)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return binary.BigEndian.AppendUint64(buf, v.Uint())
+	case reflect.Float32, reflect.Float64:
+		return binary.BigEndian.AppendUint64(buf, orderedFloatBits(v.Float()))
+	case reflect.Complex64, reflect.Complex128:
+		buf = binary.BigEndian.AppendUint64(buf, orderedFloatBits(real(v.Complex())))
+		return binary.BigEndian.AppendUint64(buf, orderedFloatBits(imag(v.Complex())))
+	case reflect.String:
+		// Zero bytes are escaped so the terminator marks the end without changing the order
+		for _, b := range []byte(v.String()) {
+			buf = append(buf, b)
+			if b == 0 {
+				buf = append(buf, 0xff)
+			}
+		}
+		return append(buf, 0, 1)
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			buf = encodeValue(buf, v.Index(i))
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).Name != "_" {
+				buf = encodeValue(buf, v.Field(i))
+			}
+		}
+	}
+	return buf
+}
+
+// Decodes a value encoded by encodeValue into v, which must be addressable, and returns the remaining data
+func decodeValue(data string, v reflect.Value) string {
+	if !v.CanSet() {
+		// Unexported struct fields can only be set through their address
+		v = reflect.NewAt(v.Type(), unsafe.Pointer(v.UnsafeAddr())).Elem()
+	}
+	switch v.Kind() {
+	case reflect.Bool:
+		v.SetBool(data[0] == 1)
+		return data[1:]
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(int64(readUint64(data) ^ 1<<63))
+		return data[8:]
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		v.SetUint(readUint64(data))
+		return data[8:]
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat(floatFromOrderedBits(readUint64(data)))
+		return data[8:]
+	case reflect.Complex64, reflect.Complex128:
+		v.SetComplex(complex(floatFromOrderedBits(readUint64(data)), floatFromOrderedBits(readUint64(data[8:]))))
+		return data[16:]
+	case reflect.String:
+		var b strings.Builder
+		for i := 0; ; i++ {
+			if data[i] == 0 {
+				if data[i+1] == 1 {
+					v.SetString(b.String())
+					return data[i+2:]
+				}
+				// Skip the escape byte which follows a zero byte
+				b.WriteByte(0)
+				i++
+				continue
+			}
+			b.WriteByte(data[i])
+		}
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			data = decodeValue(data, v.Index(i))
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).Name != "_" {
+				data = decodeValue(data, v.Field(i))
+			}
+		}
+	}
+	return data
+}
+
+func readUint32(s string) uint32 {
+	return uint32(s[0])<<24 | uint32(s[1])<<16 | uint32(s[2])<<8 | uint32(s[3])
+}
+
+func readUint64(s string) uint64 {
+	return uint64(readUint32(s))<<32 | uint64(readUint32(s[4:]))
+}
+
+// Returns the encoding of the value used as its record in a frozen set's key. Returns false if the
+// value has no encoding, because it has never been added to a frozen set and must be given an id.
+func frozenSetRecord[T comparable](value T, add bool) (string, bool) {
+	v := reflect.ValueOf(&value).Elem()
+	if encodable(v.Type()) {
+		return string(encodeValue(nil, v)), true
+	}
+	table := internTableFor[T]()
+	var id uint64
+	if add {
+		id = table.intern(value)
+	} else if existing, ok := table.id(value); ok {
+		id = existing
+	} else {
+		return "", false
+	}
+	return string(binary.BigEndian.AppendUint64(nil, id)), true
+}
+
+// An immutable set of unique values. Unlike Set, a FrozenSet is comparable: two frozen sets with the
+// same members are equal with ==, so they can be used as map keys, as Dict keys and as members of a Set.
+// The zero value is an empty set.
+//
+// Members are stored as a canonical encoding of their contents, so frozen sets of frozen sets can be
+// nested freely. Values of types containing pointers, interfaces or channels can't be encoded this way,
+// so they are assigned ids instead, and those values are retained for the lifetime of the program.
+//
+// As with map keys, a value which is not equal to itself, such as NaN, is never equal to a member:
+// each one added is a separate member, and Has always returns false for it.
+type FrozenSet[T comparable] struct {
+	// Empty for the empty set. Otherwise the number of members n, followed by the end offsets of the
+	// n sorted member records, each as a 4 byte big endian integer, followed by the records.
+	key string
+}
+
+// Returns a new frozen set containing the values provided.
+//
+// Values of types containing pointers, interfaces or channels, such as FrozenSet[*State], are kept in
+// a table shared by the whole program so that equal sets have equal keys. Every distinct value added
+// to any frozen set of such a type stays in that table, and can't be garbage collected, until the
+// program exits, so memory grows with the number of distinct values ever frozen. Prefer a pointer-free
+// member type, such as an id, for long running programs which freeze an unbounded number of values.
+func NewFrozenSet[T comparable](values ...T) FrozenSet[T] {
+	records := make([]string, 0, len(values))
+	var unequal []string
+	for _, v := range values {
+		r, _ := frozenSetRecord(v, true)
+		if v != v {
+			// Values not equal to themselves are never duplicates, as with map keys
+			unequal = append(unequal, r)
+		} else {
+			records = append(records, r)
+		}
+	}
+	sort.Strings(records)
+	unique := records[:0]
+	for _, r := range records {
+		if len(unique) == 0 || r != unique[len(unique)-1] {
+			unique = append(unique, r)
+		}
+	}
+	records = unique
+	if len(unequal) > 0 {
+		records = append(records, unequal...)
+		sort.Strings(records)
+	}
+	if len(records) == 0 {
+		return FrozenSet[T]{}
+	}
+	key := binary.BigEndian.AppendUint32(nil, uint32(len(records)))
+	end := 0
+	for _, r := range records {
+		end += len(r)
+		key = binary.BigEndian.AppendUint32(key, uint32(end))
+	}
+	for _, r := range records {
+		key = append(key, r...)
+	}
+	return FrozenSet[T]{key: string(key)}
+}
+
+// Returns the encoded record of the i-th member
+func (set FrozenSet[T]) record(i int) string {
+	data := 4 + 4*set.Len()
+	start := 0
+	if i > 0 {
+		start = int(readUint32(set.key[4*i:]))
+	}
+	end := int(readUint32(set.key[4+4*i:]))
+	return set.key[data+start : data+end]
+}
+
+// Returns a frozen set containing the difference between two or more sets
+func (set FrozenSet[T]) Difference(sets ...FrozenSet[T]) FrozenSet[T] {
+	difference := set.Thaw()
+	for _, s := range sets {
+		difference.DifferenceUpdate(s.Thaw())
+	}
+	return difference.Freeze()
+}
+
+// Returns true if the sets contain the same members. Equivalent to comparing the sets with ==.
+func (set FrozenSet[T]) Equals(set2 FrozenSet[T]) bool {
+	return set == set2
+}
+
+// Returns true if the set contains the specified element
+func (set FrozenSet[T]) Has(value T) bool {
+	record, ok := frozenSetRecord(value, false)
+	if !ok || value != value {
+		return false
+	}
+	n := set.Len()
+	i := sort.Search(n, func(i int) bool { return set.record(i) >= record })
+	return i < n && set.record(i) == record
+}
+
+// Returns a frozen set, that is the intersection of two or more sets
+func (set FrozenSet[T]) Intersection(sets ...FrozenSet[T]) FrozenSet[T] {
+	intersection := set.Thaw()
+	for _, s := range sets {
+		intersection.IntersectionUpdate(s.Thaw())
+	}
+	return intersection.Freeze()
+}
+
+// Returns true if the sets have no common elements
+func (set1 FrozenSet[T]) IsDisjoint(set2 FrozenSet[T]) bool {
+	return set1.Thaw().IsDisjoint(set2.Thaw())
+}
+
+// Returns true if the set is contained by the given set
+func (set1 FrozenSet[T]) IsSubset(set2 FrozenSet[T]) bool {
+	return set1.Thaw().IsSubset(set2.Thaw())
+}
+
+// Returns true is the set contains the given set
+func (set1 FrozenSet[T]) IsSuperset(set2 FrozenSet[T]) bool {
+	return set1.Thaw().IsSuperset(set2.Thaw())
+}
+
+// Returns the number of elements in the set
+func (set FrozenSet[T]) Len() int {
+	if set.key == "" {
+		return 0
+	}
+	return int(readUint32(set.key))
+}
+
+// Returns the elements contained by the set. Elements are returned in a consistent order, which is
+// ascending for numbers and strings.
+func (set FrozenSet[T]) Members() []T {
+	members := make([]T, set.Len())
+	direct := encodable(reflect.TypeOf((*T)(nil)).Elem())
+	for i := range members {
+		if direct {
+			decodeValue(set.record(i), reflect.ValueOf(&members[i]).Elem())
+		} else {
+			members[i] = internTableFor[T]().value(readUint64(set.record(i)))
+		}
+	}
+	return members
+}
+
+func (set FrozenSet[T]) String() string {
+	return fmt.Sprintf("%v", set.Members())
+}
+
+// Returns a frozen set that contains all items from both sets, except items that are present in both sets
+func (set1 FrozenSet[T]) SymmetricDifference(set2 FrozenSet[T]) FrozenSet[T] {
+	return set1.Thaw().SymmetricDifference(set2.Thaw()).Freeze()
+}
+
+// Returns a mutable set containing the members of the frozen set
+func (set FrozenSet[T]) Thaw() Set[T] {
+	return NewSet(set.Members()...)
+}
+
+// Returns a frozen set that contains all items from the given sets
+func (set FrozenSet[T]) Union(sets ...FrozenSet[T]) FrozenSet[T] {
+	union := set.Thaw()
+	for _, s := range sets {
+		union.Add(s.Members()...)
+	}
+	return union.Freeze()
+}
@@ -0,0 +1,74 @@
+package godino
+
+import (
+	"fmt"
+	"sort"
+)
+
+func ExampleNewFrozenSet() {
+	set := NewFrozenSet(1, 2, 3, 3) // Values are unique
+	fmt.Println(set.Len())
+	fmt.Println(set == NewFrozenSet(3, 2, 1))
+	// Output:
+	// 3
+	// true
+}
+
+func ExampleFrozenSet_mapKey() {
+	// Frozen sets are comparable, so they can be used as map keys
+	owners := map[FrozenSet[string]]string{
+		NewFrozenSet("read", "write"): "alice",
+		NewFrozenSet("read"):          "bob",
+	}
+	fmt.Println(owners[NewFrozenSet("write", "read")])
+	// Output: alice
+}
+
+func ExampleFrozenSet_Has() {
+	set := NewFrozenSet("a", "b")
+	fmt.Println(set.Has("a"))
+	fmt.Println(set.Has("c"))
+	// Output:
+	// true
+	// false
+}
+
+func ExampleFrozenSet_Union() {
+	set := NewFrozenSet(1, 2).Union(NewFrozenSet(2, 3))
+	members := set.Members()
+	sort.Ints(members)
+	fmt.Println(members)
+	// Output: [1 2 3]
+}
+
+func ExampleFrozenSet_Intersection() {
+	set := NewFrozenSet(1, 2, 3).Intersection(NewFrozenSet(2, 3, 4))
+	members := set.Members()
+	sort.Ints(members)
+	fmt.Println(members)
+	// Output: [2 3]
+}
+
+func ExampleFrozenSet_Difference() {
+	set := NewFrozenSet(1, 2, 3).Difference(NewFrozenSet(2, 3, 4))
+	fmt.Println(set.Members())
+	// Output: [1]
+}
+
+func ExampleFrozenSet_IsSubset() {
+	fmt.Println(NewFrozenSet(1, 2).IsSubset(NewFrozenSet(1, 2, 3)))
+	// Output: true
+}
+
+func ExampleFrozenSet_Thaw() {
+	set := NewFrozenSet(1).Thaw()
+	set.Add(2)
+	fmt.Println(len(set))
+	// Output: 2
+}
+
+func ExampleSet_Freeze() {
+	states := NewSet(NewSet(1, 2).Freeze(), NewSet(2, 1).Freeze())
+	fmt.Println(len(states))
+	// Output: 1
+}
@@ -0,0 +1,216 @@
+package godino
+
+import (
+	"math"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewFrozenSet(t *testing.T) {
+	set := NewFrozenSet(3, 1, 2, 3)
+	assert.Equal(t, 3, set.Len())
+	assert.ElementsMatch(t, []int{1, 2, 3}, set.Members())
+	assert.True(t, set.Has(1))
+	assert.False(t, set.Has(4))
+	assert.False(t, set.Has(987654321), "values never added to any set are not members")
+
+	var empty FrozenSet[int]
+	assert.Equal(t, 0, empty.Len())
+	assert.Equal(t, empty, NewFrozenSet[int]())
+	assert.Equal(t, []int{}, empty.Members())
+}
+
+func TestFrozenSetComparable(t *testing.T) {
+	t.Run("sets with the same members are equal regardless of order", func(t *testing.T) {
+		assert.True(t, NewFrozenSet("a", "b", "c") == NewFrozenSet("c", "a", "b", "a"))
+		assert.True(t, NewFrozenSet("a", "b").Equals(NewFrozenSet("b", "a")))
+		assert.False(t, NewFrozenSet("a", "b") == NewFrozenSet("a"))
+	})
+
+	t.Run("frozen sets can be used as map keys", func(t *testing.T) {
+		d := Dict[FrozenSet[int], string]{}
+		d[NewFrozenSet(1, 2)] = "one and two"
+		assert.Equal(t, "one and two", d.Get(NewFrozenSet(2, 1)))
+		assert.False(t, d.Has(NewFrozenSet(1)))
+	})
+
+	t.Run("frozen sets can be members of a set", func(t *testing.T) {
+		sets := NewSet(NewFrozenSet(1, 2), NewFrozenSet(2, 1), NewFrozenSet[int]())
+		assert.Equal(t, 2, len(sets))
+		assert.True(t, sets.Has(NewFrozenSet[int]()))
+	})
+
+	t.Run("values equal under == share an encoding", func(t *testing.T) {
+		assert.Equal(t, NewFrozenSet(0.0), NewFrozenSet(math.Copysign(0, -1)))
+		type point struct{ x, y int }
+		assert.Equal(t, NewFrozenSet(point{1, 2}), NewFrozenSet(point{1, 2}))
+	})
+
+	t.Run("values not equal to themselves are separate members, as with map keys", func(t *testing.T) {
+		nan := math.NaN()
+		frozen := NewFrozenSet(nan, 1.0, nan, 1.0)
+		assert.Equal(t, 3, frozen.Len())
+		assert.False(t, frozen.Has(nan))
+		assert.True(t, frozen.Has(1.0))
+
+		set := NewSet(nan, 1.0, nan)
+		assert.Equal(t, len(set), set.Freeze().Len())
+		thawed := set.Freeze().Thaw()
+		assert.Equal(t, len(set), len(thawed))
+		assert.False(t, thawed.Has(nan))
+
+		type sample struct{ F float64 }
+		samples := NewFrozenSet(sample{nan}, sample{nan}, sample{2})
+		assert.Equal(t, 3, samples.Len())
+		assert.False(t, samples.Has(sample{nan}))
+		assert.True(t, samples.Has(sample{2}))
+	})
+
+	t.Run("element types are kept separate", func(t *testing.T) {
+		type id int
+		ints := NewFrozenSet(7, 8)
+		ids := NewFrozenSet[id](8)
+		assert.ElementsMatch(t, []int{7, 8}, ints.Members())
+		assert.Equal(t, []id{8}, ids.Members())
+	})
+}
+
+func TestFrozenSetEncoding(t *testing.T) {
+	t.Run("should return numbers and strings in ascending order", func(t *testing.T) {
+		assert.Equal(t, []int{-5, -1, 0, 3, 100}, NewFrozenSet(3, -1, 100, 0, -5).Members())
+		assert.Equal(t, []float64{math.Inf(-1), -2.5, 0, 0.5, 7}, NewFrozenSet(0.5, 7, -2.5, math.Inf(-1), 0).Members())
+		assert.Equal(t, []string{"", "a", "a\x00", "ab", "b"}, NewFrozenSet("b", "ab", "a\x00", "", "a").Members())
+		assert.Equal(t, []uint8{0, 1, 255}, NewFrozenSet[uint8](255, 0, 1).Members())
+	})
+
+	t.Run("should round trip structs, arrays and complex numbers", func(t *testing.T) {
+		type inner struct {
+			Name  string
+			flags [2]bool
+		}
+		type record struct {
+			id    int32
+			_     int
+			value complex128
+			inner inner
+		}
+		values := []record{
+			{id: -3, value: complex(1, -2), inner: inner{"x\x00y", [2]bool{true, false}}},
+			{id: 7, inner: inner{Name: "z"}},
+		}
+		set := NewFrozenSet(values[1], values[0], values[1])
+		assert.Equal(t, values, set.Members())
+		assert.True(t, set.Has(record{id: 7, inner: inner{Name: "z"}}))
+		assert.False(t, set.Has(record{id: 7}))
+	})
+
+	t.Run("should nest frozen sets", func(t *testing.T) {
+		powerset := []FrozenSet[int]{}
+		for mask := 0; mask < 8; mask++ {
+			subset := []int{}
+			for bit := 0; bit < 3; bit++ {
+				if mask&(1<<bit) != 0 {
+					subset = append(subset, bit)
+				}
+			}
+			powerset = append(powerset, NewFrozenSet(subset...))
+		}
+		sets := NewFrozenSet(powerset...)
+		assert.Equal(t, 8, sets.Len())
+		assert.True(t, sets.Has(NewFrozenSet(2, 0)))
+		assert.False(t, sets.Has(NewFrozenSet(3)))
+		assert.ElementsMatch(t, powerset, sets.Members())
+		assert.Equal(t, sets, NewFrozenSet(sets.Members()...))
+	})
+
+	t.Run("should not retain values of pointer free types", func(t *testing.T) {
+		type unretained struct{ n int }
+		NewFrozenSet(unretained{1}, unretained{2}).Has(unretained{3})
+		NewFrozenSet(NewFrozenSet(unretained{1}))
+		_, retained := internTables.Load(reflect.TypeOf(unretained{}))
+		assert.False(t, retained)
+		_, retained = internTables.Load(reflect.TypeOf(FrozenSet[unretained]{}))
+		assert.False(t, retained)
+	})
+
+	t.Run("should assign ids to values containing pointers", func(t *testing.T) {
+		a, b := new(int), new(int)
+		set := NewFrozenSet(a, b, a)
+		assert.Equal(t, 2, set.Len())
+		assert.ElementsMatch(t, []*int{a, b}, set.Members())
+		assert.True(t, set.Has(b))
+		assert.False(t, set.Has(new(int)))
+		assert.Equal(t, set, NewFrozenSet(b, a))
+
+		type node struct {
+			name string
+			next *int
+		}
+		assert.Equal(t, []node{{"x", a}}, NewFrozenSet(node{"x", a}, node{"x", a}).Members())
+	})
+
+	t.Run("should check membership without decoding every member", func(t *testing.T) {
+		values := make([]int, 1000)
+		for i := range values {
+			values[i] = i * 3
+		}
+		set := NewFrozenSet(values...)
+		assert.True(t, set.Has(999))
+		assert.False(t, set.Has(1000))
+		allocs := testing.AllocsPerRun(100, func() { set.Has(1500) })
+		assert.LessOrEqual(t, allocs, 3.0)
+	})
+}
+
+func TestFrozenSetOperations(t *testing.T) {
+	a := NewFrozenSet(1, 2, 3)
+	b := NewFrozenSet(3, 4, 5)
+	c := NewFrozenSet(1, 5)
+
+	assert.Equal(t, NewFrozenSet(1, 2, 3, 4, 5), a.Union(b))
+	assert.Equal(t, NewFrozenSet(1, 2, 3, 4, 5), a.Union(b, c))
+	assert.Equal(t, a, a.Union())
+	assert.Equal(t, NewFrozenSet(3), a.Intersection(b))
+	assert.Equal(t, NewFrozenSet[int](), a.Intersection(b, c))
+	assert.Equal(t, NewFrozenSet(1, 2), a.Difference(b))
+	assert.Equal(t, NewFrozenSet(2), a.Difference(b, c))
+	assert.Equal(t, NewFrozenSet(1, 2, 4, 5), a.SymmetricDifference(b))
+
+	assert.True(t, NewFrozenSet(1, 2).IsSubset(a))
+	assert.False(t, a.IsSubset(b))
+	assert.True(t, a.IsSuperset(NewFrozenSet(1, 2)))
+	assert.False(t, a.IsSuperset(b))
+	assert.True(t, NewFrozenSet(1, 2).IsDisjoint(b))
+	assert.False(t, a.IsDisjoint(b))
+}
+
+func TestFrozenSetConversions(t *testing.T) {
+	set := NewSet(1, 2, 3)
+	frozen := set.Freeze()
+	set.Add(4)
+	assert.Equal(t, 3, frozen.Len(), "freezing copies the members")
+
+	thawed := frozen.Thaw()
+	thawed.Add(5)
+	assert.False(t, frozen.Has(5), "thawing copies the members")
+	assert.True(t, thawed.Equals(NewSet(1, 2, 3, 5)))
+}
+
+func TestFrozenSetConcurrentCreation(t *testing.T) {
+	var wg sync.WaitGroup
+	sets := make([]FrozenSet[string], 8)
+	for i := range sets {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			sets[i] = NewFrozenSet("x", "y", "z", "w")
+		}(i)
+	}
+	wg.Wait()
+	for _, s := range sets {
+		assert.Equal(t, sets[0], s)
+	}
+}
@@ -0,0 +1,87 @@
+package godino
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"reflect"
+)
+
+// Returns a 64 bit hash of the value which is the same on every run of the program, so that the
+// probabilistic counters give reproducible results. Values equal under == hash the same, including -0
+// and 0, and every NaN is treated as one value. Types without pointers, interfaces or channels are hashed
+// from the same encoding as FrozenSet members. Other types are hashed from their Go-syntax representation,
+// so pointers are only hashed consistently within a single run. The seed selects one of a family of
+// independent hash functions.
+func hashValue[T comparable](value T, seed uint64) uint64 {
+	h := fnv.New64a()
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], seed)
+	h.Write(buf[:])
+	writeUint := func(n uint64) {
+		binary.LittleEndian.PutUint64(buf[:], n)
+		h.Write(buf[:])
+	}
+	switch v := any(value).(type) {
+	case string:
+		io.WriteString(h, v)
+	case bool:
+		if v {
+			writeUint(1)
+		} else {
+			writeUint(0)
+		}
+	case int:
+		writeUint(uint64(v))
+	case int8:
+		writeUint(uint64(v))
+	case int16:
+		writeUint(uint64(v))
+	case int32:
+		writeUint(uint64(v))
+	case int64:
+		writeUint(uint64(v))
+	case uint:
+		writeUint(uint64(v))
+	case uint8:
+		writeUint(uint64(v))
+	case uint16:
+		writeUint(uint64(v))
+	case uint32:
+		writeUint(uint64(v))
+	case uint64:
+		writeUint(v)
+	case uintptr:
+		writeUint(uint64(v))
+	case float32:
+		writeUint(orderedFloatBits(float64(v)))
+	case float64:
+		writeUint(orderedFloatBits(v))
+	case complex64:
+		writeUint(orderedFloatBits(float64(real(v))))
+		writeUint(orderedFloatBits(float64(imag(v))))
+	case complex128:
+		writeUint(orderedFloatBits(real(v)))
+		writeUint(orderedFloatBits(imag(v)))
+	default:
+		rv := reflect.ValueOf(&value).Elem()
+		if encodable(rv.Type()) {
+			var scratch [64]byte
+			h.Write(encodeValue(scratch[:0], rv))
+		} else {
+			fmt.Fprintf(h, "%#v", v)
+		}
+	}
+	return mixHash(h.Sum64())
+}
+
+// Spreads the bits of an FNV hash, whose high bits depend poorly on the last bytes written
+func mixHash(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
@@ -0,0 +1,71 @@
+package godino
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHashValue(t *testing.T) {
+	t.Run("should be deterministic", func(t *testing.T) {
+		assert.Equal(t, uint64(0xf4bd69e3a46b8549), hashValue("foo", 0))
+		assert.Equal(t, hashValue(42, 3), hashValue(42, 3))
+		type point struct{ x, y int }
+		assert.Equal(t, hashValue(point{1, 2}, 0), hashValue(point{1, 2}, 0))
+	})
+
+	t.Run("should depend on the value and the seed", func(t *testing.T) {
+		assert.NotEqual(t, hashValue("foo", 0), hashValue("bar", 0))
+		assert.NotEqual(t, hashValue("foo", 0), hashValue("foo", 1))
+		assert.NotEqual(t, hashValue(1.5, 0), hashValue(2.5, 0))
+		assert.NotEqual(t, hashValue(true, 0), hashValue(false, 0))
+		type point struct{ x, y int }
+		assert.NotEqual(t, hashValue(point{1, 2}, 0), hashValue(point{2, 1}, 0))
+	})
+
+	t.Run("should hash positive and negative zero the same", func(t *testing.T) {
+		negativeZero := math.Copysign(0, -1)
+		assert.Equal(t, hashValue(0.0, 0), hashValue(negativeZero, 0))
+		assert.Equal(t, hashValue(float32(0), 0), hashValue(float32(negativeZero), 0))
+		assert.Equal(t, hashValue(complex(0, 0), 0), hashValue(complex(negativeZero, negativeZero), 0))
+
+		s := NewCountMinSketch[float64](0.01, 0.01)
+		s.Add(0.0)
+		assert.Equal(t, 1, s.Estimate(negativeZero))
+		h := NewHyperLogLog[float64]()
+		h.Add(0.0)
+		h.Add(negativeZero)
+		assert.Equal(t, 1, h.Count())
+	})
+
+	t.Run("should hash equal structs the same", func(t *testing.T) {
+		type sample struct {
+			Name  string
+			Value float64
+		}
+		negativeZero := math.Copysign(0, -1)
+		assert.Equal(t, hashValue(sample{"a", 0}, 0), hashValue(sample{"a", negativeZero}, 0))
+		assert.NotEqual(t, hashValue(sample{"a", 1}, 0), hashValue(sample{"b", 1}, 0))
+
+		h := NewHyperLogLog[sample]()
+		h.Add(sample{"a", 0})
+		h.Add(sample{"a", negativeZero})
+		assert.Equal(t, 1, h.Count())
+	})
+
+	t.Run("should hash named types from their contents", func(t *testing.T) {
+		type celsius float64
+		assert.Equal(t, hashValue(celsius(0), 0), hashValue(celsius(math.Copysign(0, -1)), 0))
+		assert.NotEqual(t, hashValue(celsius(1), 0), hashValue(celsius(2), 0))
+		assert.Equal(t, hashValue(celsius(math.NaN()), 0), hashValue(celsius(-math.NaN()), 0))
+	})
+
+	t.Run("should hash every NaN the same", func(t *testing.T) {
+		nan1 := math.NaN()
+		nan2 := math.Float64frombits(0x7ff8000000000abc)
+		assert.Equal(t, hashValue(nan1, 0), hashValue(nan2, 0))
+		assert.Equal(t, hashValue(nan1, 0), hashValue(-nan1, 0))
+		assert.NotEqual(t, hashValue(nan1, 0), hashValue(math.Inf(1), 0))
+	})
+}
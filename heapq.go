@@ -0,0 +1,102 @@
+package godino
+
+import (
+	"container/heap"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Adapts a slice to heap.Interface so it can be used as a min-heap
+type sliceHeap[L ~[]T, T constraints.Ordered] struct {
+	arr *L
+}
+
+func (h sliceHeap[L, T]) Len() int { return len(*h.arr) }
+
+func (h sliceHeap[L, T]) Less(i, j int) bool { return (*h.arr)[i] < (*h.arr)[j] }
+
+func (h sliceHeap[L, T]) Swap(i, j int) { (*h.arr)[i], (*h.arr)[j] = (*h.arr)[j], (*h.arr)[i] }
+
+func (h sliceHeap[L, T]) Push(x any) { *h.arr = append(*h.arr, x.(T)) }
+
+func (h sliceHeap[L, T]) Pop() any { return Pop(h.arr) }
+
+// Rearranges the array in place so it satisfies the heap property, with the smallest element first.
+// Runs in O(n) time.
+func Heapify[L ~[]T, T constraints.Ordered](arr L) {
+	heap.Init(sliceHeap[L, T]{&arr})
+}
+
+// Removes and returns the smallest element of the heap, maintaining the heap property.
+// Panics if the heap is empty.
+func HeapPop[L ~[]T, T constraints.Ordered](arr *L) T {
+	return heap.Pop(sliceHeap[L, T]{arr}).(T)
+}
+
+// Adds the value to the heap, maintaining the heap property
+func HeapPush[L ~[]T, T constraints.Ordered](arr *L, value T) {
+	heap.Push(sliceHeap[L, T]{arr}, value)
+}
+
+// Adds the value to the heap and then removes and returns the smallest element.
+// This is more efficient than calling HeapPush followed by HeapPop.
+func HeapPushPop[L ~[]T, T constraints.Ordered](arr *L, value T) T {
+	if len(*arr) == 0 || value <= (*arr)[0] {
+		return value
+	}
+	smallest := (*arr)[0]
+	(*arr)[0] = value
+	heap.Fix(sliceHeap[L, T]{arr}, 0)
+	return smallest
+}
+
+// Removes and returns the smallest element of the heap and then adds the value.
+// This is more efficient than calling HeapPop followed by HeapPush. Panics if the heap is empty.
+func HeapReplace[L ~[]T, T constraints.Ordered](arr *L, value T) T {
+	smallest := (*arr)[0]
+	(*arr)[0] = value
+	heap.Fix(sliceHeap[L, T]{arr}, 0)
+	return smallest
+}
+
+// Returns an iterator which merges the sorted iterators into a single sorted iterator.
+// Equal elements are returned in the order of the iterators they came from.
+// The largest or smallest n elements of a slice can be found with NLargest and NSmallest.
+func Merge[T constraints.Ordered](its ...*Iterator[T]) *Iterator[T] {
+	return MergeFunc(func(a, b T) bool { return a < b }, its...)
+}
+
+// The next element of one of the iterators being merged, and the index of that iterator
+type mergeHead[T any] struct {
+	value  T
+	source int
+}
+
+// Returns an iterator which merges the iterators, each sorted according to less, into a single sorted iterator.
+// Equal elements are returned in the order of the iterators they came from.
+func MergeFunc[T any](less func(a, b T) bool, its ...*Iterator[T]) *Iterator[T] {
+	var heads *PriorityQueue[mergeHead[T]]
+	return NewIterator(func() (T, bool) {
+		if heads == nil {
+			heads = NewPriorityQueueFunc(func(a, b mergeHead[T]) bool {
+				if less(a.value, b.value) {
+					return true
+				}
+				return !less(b.value, a.value) && a.source < b.source
+			})
+			for i, it := range its {
+				if it.Next() {
+					heads.Push(mergeHead[T]{it.Value(), i})
+				}
+			}
+		}
+		next, err := heads.Pop()
+		if err != nil {
+			return next.value, false
+		}
+		if it := its[next.source]; it.Next() {
+			heads.Push(mergeHead[T]{it.Value(), next.source})
+		}
+		return next.value, true
+	})
+}
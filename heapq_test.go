@@ -0,0 +1,107 @@
+package godino
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func isHeap[T Real](arr []T) bool {
+	for i := 1; i < len(arr); i++ {
+		if arr[i] < arr[(i-1)/2] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestHeapq(t *testing.T) {
+	t.Run("should heapify an array in place", func(t *testing.T) {
+		arr := []int{9, 4, 7, 1, 8, 2, 6, 3, 5}
+		Heapify(arr)
+		assert.True(t, isHeap(arr))
+		assert.Equal(t, 1, arr[0])
+	})
+
+	t.Run("should push and pop values", func(t *testing.T) {
+		h := []int{}
+		for _, v := range []int{5, 3, 8, 1, 9, 2} {
+			HeapPush(&h, v)
+			assert.True(t, isHeap(h))
+		}
+		popped := []int{}
+		for len(h) > 0 {
+			popped = append(popped, HeapPop(&h))
+			assert.True(t, isHeap(h))
+		}
+		assert.Equal(t, []int{1, 2, 3, 5, 8, 9}, popped)
+		assert.Panics(t, func() { HeapPop(&h) })
+	})
+
+	t.Run("should push and pop in a single operation", func(t *testing.T) {
+		h := []float64{2, 4, 3}
+		Heapify(h)
+		assert.Equal(t, 1.0, HeapPushPop(&h, 1))
+		assert.Equal(t, 2.0, HeapPushPop(&h, 5))
+		assert.True(t, isHeap(h))
+		assert.ElementsMatch(t, []float64{3, 4, 5}, h)
+		empty := []float64{}
+		assert.Equal(t, 1.0, HeapPushPop(&empty, 1))
+	})
+
+	t.Run("should pop and push in a single operation", func(t *testing.T) {
+		h := []int{2, 4, 3}
+		Heapify(h)
+		assert.Equal(t, 2, HeapReplace(&h, 1))
+		assert.Equal(t, 1, HeapReplace(&h, 5))
+		assert.True(t, isHeap(h))
+		assert.ElementsMatch(t, []int{3, 4, 5}, h)
+		empty := []int{}
+		assert.Panics(t, func() { HeapReplace(&empty, 1) })
+	})
+
+	t.Run("should work with named slice types", func(t *testing.T) {
+		h := List[int]{3, 1, 2}
+		Heapify(h)
+		HeapPush(&h, 0)
+		assert.Equal(t, 0, HeapPop(&h))
+		assert.Equal(t, 1, HeapPop(&h))
+	})
+}
+
+func TestMerge(t *testing.T) {
+	t.Run("should merge sorted iterators", func(t *testing.T) {
+		merged := Merge(Iter([]int{1, 4, 7}), Iter([]int{2, 5, 8}), Iter([]int{}), Iter([]int{0, 3, 6, 9}))
+		assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, merged.ToArray())
+		assert.Empty(t, Merge[int]().ToArray())
+	})
+
+	t.Run("should keep equal elements in the order of their iterators", func(t *testing.T) {
+		type pair struct {
+			key    int
+			source string
+		}
+		merged := MergeFunc(func(a, b pair) bool { return a.key < b.key },
+			Iter([]pair{{1, "a"}, {2, "a"}}),
+			Iter([]pair{{1, "b"}, {2, "b"}}),
+		)
+		assert.Equal(t, []pair{{1, "a"}, {1, "b"}, {2, "a"}, {2, "b"}}, merged.ToArray())
+	})
+
+	t.Run("should merge iterators in reverse order", func(t *testing.T) {
+		merged := MergeFunc(func(a, b int) bool { return a > b }, Iter([]int{9, 5, 1}), Iter([]int{8, 2}))
+		expected := []int{9, 8, 5, 2, 1}
+		assert.True(t, sort.SliceIsSorted(expected, func(i, j int) bool { return expected[i] > expected[j] }))
+		assert.Equal(t, expected, merged.ToArray())
+	})
+
+	t.Run("should pull values lazily", func(t *testing.T) {
+		merged := Merge(CountFrom(0, 2), CountFrom(1, 2))
+		first := []int{}
+		for i := 0; i < 5 && merged.Next(); i++ {
+			first = append(first, merged.Value())
+		}
+		assert.Equal(t, []int{0, 1, 2, 3, 4}, first)
+	})
+}
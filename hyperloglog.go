@@ -0,0 +1,95 @@
+package godino
+
+import (
+	"errors"
+	"math"
+	"math/bits"
+)
+
+// A HyperLogLog estimates the number of distinct elements added to it using 2^precision bytes of
+// memory. The standard error of the estimate is about 1.04 / sqrt(2^precision), e.g. 0.8% for the
+// default precision of 14.
+type HyperLogLog[T comparable] struct {
+	registers []uint8
+	precision uint8
+}
+
+// Returns a new HyperLogLog using 2^precision registers. If precision is not given, 14 is used.
+// Panics if the precision is not between 4 and 16.
+func NewHyperLogLog[T comparable](precision ...int) *HyperLogLog[T] {
+	p := 14
+	if len(precision) >= 1 {
+		p = precision[0]
+	}
+	if p < 4 || p > 16 {
+		panic("NewHyperLogLog() precision must be between 4 and 16")
+	}
+	return &HyperLogLog[T]{registers: make([]uint8, 1<<p), precision: uint8(p)}
+}
+
+// Adds an element to the set of elements being counted
+func (h *HyperLogLog[T]) Add(value T) {
+	hash := hashValue(value, 0)
+	i := hash >> (64 - h.precision)
+	// Set a sentinel bit so the rank is at most 64 - precision + 1
+	rest := hash<<h.precision | 1<<(h.precision-1)
+	if rank := uint8(bits.LeadingZeros64(rest) + 1); rank > h.registers[i] {
+		h.registers[i] = rank
+	}
+}
+
+// Removes all elements
+func (h *HyperLogLog[T]) Clear() {
+	for i := range h.registers {
+		h.registers[i] = 0
+	}
+}
+
+// Returns the estimated number of distinct elements which have been added
+func (h *HyperLogLog[T]) Count() int {
+	m := float64(len(h.registers))
+	sum := 0.0
+	zeros := 0
+	for _, r := range h.registers {
+		sum += math.Ldexp(1, -int(r))
+		if r == 0 {
+			zeros++
+		}
+	}
+	var alpha float64
+	switch len(h.registers) {
+	case 16:
+		alpha = 0.673
+	case 32:
+		alpha = 0.697
+	case 64:
+		alpha = 0.709
+	default:
+		alpha = 0.7213 / (1 + 1.079/m)
+	}
+	estimate := alpha * m * m / sum
+	// Use linear counting when the estimate is small, where it is more accurate
+	if estimate <= 2.5*m && zeros > 0 {
+		estimate = m * math.Log(m/float64(zeros))
+	}
+	return int(math.Round(estimate))
+}
+
+// Adds the elements of another HyperLogLog, as if every element added to it had been added to this one.
+// Returns an error if the precisions are different.
+func (h *HyperLogLog[T]) Merge(other *HyperLogLog[T]) error {
+	if h.precision != other.precision {
+		return errors.New("Merge() HyperLogLogs have different precisions")
+	}
+	for i, r := range other.registers {
+		if r > h.registers[i] {
+			h.registers[i] = r
+		}
+	}
+	return nil
+}
+
+// Returns the precision, the base 2 logarithm of the number of registers
+func (h *HyperLogLog[T]) Precision() int {
+	return int(h.precision)
+}
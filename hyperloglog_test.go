@@ -0,0 +1,70 @@
+package godino
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHyperLogLog(t *testing.T) {
+	t.Run("should count small sets exactly", func(t *testing.T) {
+		h := NewHyperLogLog[string]()
+		assert.Equal(t, 0, h.Count())
+		for _, v := range []string{"a", "b", "c", "a", "b", "a"} {
+			h.Add(v)
+		}
+		assert.Equal(t, 3, h.Count())
+		assert.Equal(t, 14, h.Precision())
+	})
+
+	t.Run("should estimate large sets within the standard error", func(t *testing.T) {
+		for _, precision := range []int{4, 10, 14} {
+			h := NewHyperLogLog[int](precision)
+			n := 100000
+			for i := 0; i < n; i++ {
+				h.Add(i)
+				h.Add(i)
+			}
+			stdErr := 1.04 / math.Sqrt(float64(int(1)<<precision))
+			assert.InDelta(t, n, h.Count(), 3*stdErr*float64(n), "precision %d", precision)
+		}
+	})
+
+	t.Run("should merge with the union of the elements", func(t *testing.T) {
+		h1 := NewHyperLogLog[string](12)
+		h2 := NewHyperLogLog[string](12)
+		for i := 0; i < 3000; i++ {
+			h1.Add(fmt.Sprint(i))
+			h2.Add(fmt.Sprint(i + 2000))
+		}
+		assert.NoError(t, h1.Merge(h2))
+		assert.InDelta(t, 5000, h1.Count(), 250)
+		assert.EqualError(t, h1.Merge(NewHyperLogLog[string](10)), "Merge() HyperLogLogs have different precisions")
+	})
+
+	t.Run("should give the same estimate on every run", func(t *testing.T) {
+		h := NewHyperLogLog[int](8)
+		for i := 0; i < 1000; i++ {
+			h.Add(i)
+		}
+		h2 := NewHyperLogLog[int](8)
+		for i := 999; i >= 0; i-- {
+			h2.Add(i)
+		}
+		assert.Equal(t, h.Count(), h2.Count())
+	})
+
+	t.Run("should clear the registers", func(t *testing.T) {
+		h := NewHyperLogLog[int](4)
+		h.Add(1)
+		h.Clear()
+		assert.Equal(t, 0, h.Count())
+	})
+
+	t.Run("should panic with an invalid precision", func(t *testing.T) {
+		assert.Panics(t, func() { NewHyperLogLog[int](3) })
+		assert.Panics(t, func() { NewHyperLogLog[int](17) })
+	})
+}
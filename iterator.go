@@ -0,0 +1,108 @@
+package godino
+
+import "context"
+
+// A lazily evaluated sequence of values. Values can be pulled one at a time with Next and Value,
+// or pushed to a callback with Each. Iterators do not start any goroutines, so an iterator that is
+// abandoned before it is exhausted does not leak.
+type Iterator[T any] struct {
+	next  func() (T, bool)
+	value T
+	done  bool
+	err   error
+}
+
+// Returns an iterator that produces values by calling next until it returns false
+func NewIterator[T any](next func() (T, bool)) *Iterator[T] {
+	return &Iterator[T]{next: next}
+}
+
+func emptyIterator[T any]() *Iterator[T] {
+	return &Iterator[T]{done: true}
+}
+
+// Returns an iterator over the elements of the array
+func Iter[T any](arr []T) *Iterator[T] {
+	i := 0
+	return NewIterator(func() (T, bool) {
+		var value T
+		if i >= len(arr) {
+			return value, false
+		}
+		value = arr[i]
+		i++
+		return value, true
+	})
+}
+
+// Returns an iterator that produces the values of the given iterator until the context is cancelled.
+// Once the context is cancelled, Next returns false and Err returns the context's error.
+func WithContext[T any](ctx context.Context, it *Iterator[T]) *Iterator[T] {
+	var wrapped *Iterator[T]
+	wrapped = NewIterator(func() (T, bool) {
+		var value T
+		if err := ctx.Err(); err != nil {
+			wrapped.err = err
+			it.Stop()
+			return value, false
+		}
+		if !it.Next() {
+			wrapped.err = it.Err()
+			return value, false
+		}
+		return it.Value(), true
+	})
+	return wrapped
+}
+
+// Pushes each remaining value to the given function until the iterator is exhausted or the function
+// returns false. The iterator can be resumed after returning early.
+func (it *Iterator[T]) Each(yield func(T) bool) {
+	for it.Next() {
+		if !yield(it.Value()) {
+			return
+		}
+	}
+}
+
+// Returns the error that stopped the iteration, if any. This is the context's error for iterators
+// that were stopped by a cancelled context.
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// Advances the iterator to the next value. Returns false when there are no more values.
+func (it *Iterator[T]) Next() bool {
+	if it.done {
+		return false
+	}
+	value, ok := it.next()
+	if !ok {
+		it.Stop()
+		return false
+	}
+	it.value = value
+	return true
+}
+
+// Ends the iteration early. Subsequent calls to Next return false.
+func (it *Iterator[T]) Stop() {
+	var zero T
+	it.done = true
+	it.value = zero
+	it.next = nil
+}
+
+// Returns an array containing the remaining values of the iterator
+func (it *Iterator[T]) ToArray() []T {
+	arr := []T{}
+	for it.Next() {
+		arr = append(arr, it.Value())
+	}
+	return arr
+}
+
+// Returns the current value of the iterator. Only valid after a call to Next has returned true.
+func (it *Iterator[T]) Value() T {
+	return it.value
+}
@@ -0,0 +1,53 @@
+package godino
+
+import (
+	"context"
+	"fmt"
+)
+
+func ExampleIter() {
+	it := Iter([]string{"a", "b", "c"})
+	for it.Next() {
+		fmt.Println(it.Value())
+	}
+	// Output:
+	// a
+	// b
+	// c
+}
+
+func ExampleIterator_Each() {
+	it := Iter([]int{1, 2, 3, 4, 5})
+	it.Each(func(n int) bool {
+		fmt.Println(n)
+		return n < 3 // Returning false stops the iteration early
+	})
+	fmt.Println(it.ToArray())
+	// Output:
+	// 1
+	// 2
+	// 3
+	// [4 5]
+}
+
+func ExampleNewIterator() {
+	a, b := 0, 1
+	fib := NewIterator(func() (int, bool) {
+		a, b = b, a+b
+		return a, a < 20
+	})
+	fmt.Println(fib.ToArray())
+	// Output: [1 1 2 3 5 8 13]
+}
+
+func ExampleWithContext() {
+	ctx, cancel := context.WithCancel(context.Background())
+	it := WithContext(ctx, Iter([]int{1, 2, 3}))
+	it.Next()
+	fmt.Println(it.Value())
+	cancel()
+	fmt.Println(it.Next(), it.Err())
+	// Output:
+	// 1
+	// false context canceled
+}
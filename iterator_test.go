@@ -0,0 +1,67 @@
+package godino
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIterator(t *testing.T) {
+	t.Run("should pull values until exhausted", func(t *testing.T) {
+		it := Iter([]int{1, 2, 3})
+		values := []int{}
+		for it.Next() {
+			values = append(values, it.Value())
+		}
+		assert.Equal(t, []int{1, 2, 3}, values)
+		assert.False(t, it.Next())
+		assert.NoError(t, it.Err())
+	})
+
+	t.Run("should push values until the callback returns false and allow resuming", func(t *testing.T) {
+		it := Iter([]int{1, 2, 3, 4})
+		values := []int{}
+		it.Each(func(v int) bool {
+			values = append(values, v)
+			return v < 2
+		})
+		assert.Equal(t, []int{1, 2}, values)
+		assert.Equal(t, []int{3, 4}, it.ToArray())
+	})
+
+	t.Run("should stop producing values once stopped", func(t *testing.T) {
+		it := Iter([]int{1, 2, 3})
+		it.Next()
+		it.Stop()
+		assert.False(t, it.Next())
+		assert.Equal(t, 0, it.Value())
+	})
+
+	t.Run("should build an iterator from a function", func(t *testing.T) {
+		i := 0
+		it := NewIterator(func() (int, bool) {
+			i++
+			return i * i, i <= 3
+		})
+		assert.Equal(t, []int{1, 4, 9}, it.ToArray())
+	})
+}
+
+func TestWithContext(t *testing.T) {
+	t.Run("should stop when the context is cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		it := WithContext(ctx, Iter([]int{1, 2, 3}))
+		assert.True(t, it.Next())
+		assert.Equal(t, 1, it.Value())
+		cancel()
+		assert.False(t, it.Next())
+		assert.ErrorIs(t, it.Err(), context.Canceled)
+	})
+
+	t.Run("should not report an error when exhausted before cancellation", func(t *testing.T) {
+		it := WithContext(context.Background(), Iter([]int{1, 2}))
+		assert.Equal(t, []int{1, 2}, it.ToArray())
+		assert.NoError(t, it.Err())
+	})
+}
@@ -1,78 +1,683 @@
 package godino
 
-func generateCombinations[T any](arr []T, length int, combination []T, results chan []T) {
-	if length == 0 {
-		temp := make([]T, len(combination))
-		copy(temp, combination)
-		results <- temp
-	} else {
-		for i := 0; i <= len(arr)-length; i++ {
-			combination[len(combination)-length] = arr[i]
-			generateCombinations(arr[i+1:], length-1, combination, results)
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// Returns an iterator over all possible subsets of the array of the given length
+func Combinations[T any](arr []T, length int) *Iterator[[]T] {
+	pool := Copy(arr)
+	n := len(pool)
+	if length < 0 || length > n {
+		return emptyIterator[[]T]()
+	}
+	indices := make([]int, length)
+	for i := range indices {
+		indices[i] = i
+	}
+	started := false
+	return NewIterator(func() ([]T, bool) {
+		if started && !nextCombination(indices, n) {
+			return nil, false
 		}
+		started = true
+		return selectIndices(pool, indices), true
+	})
+}
+
+// Advances the indices of a combination of n elements to the next combination in lexicographic order.
+// Returns false if the indices were the last combination.
+func nextCombination(indices []int, n int) bool {
+	length := len(indices)
+	i := length - 1
+	for i >= 0 && indices[i] == i+n-length {
+		i--
+	}
+	if i < 0 {
+		return false
 	}
+	indices[i]++
+	for j := i + 1; j < length; j++ {
+		indices[j] = indices[j-1] + 1
+	}
+	return true
 }
 
-// Returns an iterable containing all possible subsets of the array of the given length
-func Combinations[T any](arr []T, length int) <-chan []T {
-	results := make(chan []T)
-	go func() {
-		defer close(results)
-		combination := make([]T, length)
-		generateCombinations(arr, length, combination, results)
-	}()
-	return results
+func selectIndices[T any](pool []T, indices []int) []T {
+	selected := make([]T, len(indices))
+	for i, index := range indices {
+		selected[i] = pool[index]
+	}
+	return selected
 }
 
-func swap[T any](arr []T, i, j int) {
-	arr[i], arr[j] = arr[j], arr[i]
+// Returns an iterator over all possible subsets of the array of the given length which stops
+// when the context is cancelled
+func CombinationsContext[T any](ctx context.Context, arr []T, length int) *Iterator[[]T] {
+	return WithContext(ctx, Combinations(arr, length))
 }
 
-func permutations[T any](arr []T, start, end int, length int, results chan []T) {
-	if start == length {
-		temp := make([]T, length)
-		copy(temp, arr[:length])
-		results <- temp
-	} else {
-		for i := start; i <= end; i++ {
-			swap(arr, start, i)
-			permutations(arr, start+1, end, length, results)
-			swap(arr, start, i) // backtrack
-		}
+// Returns an iterator over all possible subsets of the array of the given length, allowing
+// individual elements to be repeated. Subsets are produced in lexicographic order of their indices.
+func CombinationsWithReplacement[T any](arr []T, length int) *Iterator[[]T] {
+	pool := Copy(arr)
+	n := len(pool)
+	if length < 0 || (n == 0 && length > 0) {
+		return emptyIterator[[]T]()
 	}
+	indices := make([]int, length)
+	started := false
+	return NewIterator(func() ([]T, bool) {
+		if started {
+			i := length - 1
+			for i >= 0 && indices[i] == n-1 {
+				i--
+			}
+			if i < 0 {
+				return nil, false
+			}
+			value := indices[i] + 1
+			for j := i; j < length; j++ {
+				indices[j] = value
+			}
+		}
+		started = true
+		return selectIndices(pool, indices), true
+	})
 }
 
-// Returns an iterable containing all possible orderings of subsets of array of the given length.
+// Returns an iterator over the unique orderings of subsets of the array of the given length,
+// skipping orderings that only differ by the positions of equal elements.
 // If -1 is provided for the length, the length of the array is used.
-func Permutations[T any](arr []T, length int) <-chan []T {
+// Orderings are produced in lexicographic order, where equal elements rank by their first occurrence
+// in the array, so a sorted array produces sorted orderings.
+func DistinctPermutations[T comparable](arr []T, length int) *Iterator[[]T] {
+	counter := NewCounter(arr)
+	values := counter.keys
+	available := make([]int, len(values))
+	for i, v := range values {
+		available[i] = counter.Get(v)
+	}
 	if length == -1 {
 		length = len(arr)
 	}
-	results := make(chan []T)
-	go func() {
-		defer close(results)
-		permutations(arr, 0, len(arr)-1, length, results)
-	}()
-	return results
+	if length < 0 || length > len(arr) {
+		return emptyIterator[[]T]()
+	}
+	// ranks[i] is the position in values of the element at position i of the current ordering
+	ranks := make([]int, length)
+	fill := func(start int) {
+		next := 0
+		for i := start; i < length; i++ {
+			for available[next] == 0 {
+				next++
+			}
+			ranks[i] = next
+			available[next]--
+		}
+	}
+	advance := func() bool {
+		for i := length - 1; i >= 0; i-- {
+			available[ranks[i]]++
+			for v := ranks[i] + 1; v < len(values); v++ {
+				if available[v] > 0 {
+					ranks[i] = v
+					available[v]--
+					fill(i + 1)
+					return true
+				}
+			}
+		}
+		return false
+	}
+	started := false
+	return NewIterator(func() ([]T, bool) {
+		if started {
+			if !advance() {
+				return nil, false
+			}
+		} else {
+			started = true
+			fill(0)
+		}
+		return selectIndices(values, ranks), true
+	})
+}
+
+// Returns an iterator over all possible orderings of subsets of array of the given length.
+// If -1 is provided for the length, the length of the array is used.
+// Orderings are produced in lexicographic order of their indices, matching Python's itertools.permutations.
+func Permutations[T any](arr []T, length int) *Iterator[[]T] {
+	pool := Copy(arr)
+	n := len(pool)
+	if length == -1 {
+		length = n
+	}
+	if length < 0 || length > n {
+		return emptyIterator[[]T]()
+	}
+	indices := make([]int, n)
+	for i := range indices {
+		indices[i] = i
+	}
+	cycles := make([]int, length)
+	for i := range cycles {
+		cycles[i] = n - i
+	}
+	started := false
+	return NewIterator(func() ([]T, bool) {
+		if started {
+			i := length - 1
+			for ; i >= 0; i-- {
+				cycles[i]--
+				if cycles[i] > 0 {
+					j := n - cycles[i]
+					indices[i], indices[j] = indices[j], indices[i]
+					break
+				}
+				// Rotate the exhausted index to the end and reset its cycle
+				first := indices[i]
+				copy(indices[i:], indices[i+1:])
+				indices[n-1] = first
+				cycles[i] = n - i
+			}
+			if i < 0 {
+				return nil, false
+			}
+		}
+		started = true
+		return selectIndices(pool, indices[:length]), true
+	})
+}
+
+// Returns an iterator over all possible orderings of subsets of array of the given length which
+// stops when the context is cancelled. If -1 is provided for the length, the length of the array is used.
+func PermutationsContext[T any](ctx context.Context, arr []T, length int) *Iterator[[]T] {
+	return WithContext(ctx, Permutations(arr, length))
+}
+
+// Returns the number of subsets of the array of the given length.
+// Returns an error if the length is negative or the result overflows an int.
+func NumCombinations[T any](arr []T, length int) (int, error) {
+	return Comb(len(arr), length)
+}
+
+// Returns the number of subsets of the array of the given length when elements may be repeated.
+// Returns an error if the length is negative or the result overflows an int.
+func NumCombinationsWithReplacement[T any](arr []T, length int) (int, error) {
+	n := len(arr)
+	if n == 0 && length >= 0 {
+		if length == 0 {
+			return 1, nil
+		}
+		return 0, nil
+	}
+	return Comb(n+length-1, length)
+}
+
+// Returns the number of orderings of subsets of the array of the given length.
+// If the length is omitted or -1, the length of the array is used.
+// Returns an error if the length is negative or the result overflows an int.
+func NumPermutations[T any](arr []T, length ...int) (int, error) {
+	k := len(arr)
+	if len(length) >= 1 && length[0] != -1 {
+		k = length[0]
+	}
+	return Perm(len(arr), k)
 }
 
-func factorial(n int) int {
-	if n <= 1 {
-		return 1
+// Returns the number of unique orderings of subsets of the array of the given length, as produced by
+// DistinctPermutations. If the length is omitted or -1, the length of the array is used.
+// Returns an error if the length is negative or the result overflows an int.
+func NumDistinctPermutations[T comparable](arr []T, length ...int) (int, error) {
+	counter := NewCounter(arr)
+	k := len(arr)
+	if len(length) >= 1 && length[0] != -1 {
+		k = length[0]
+	}
+	if err := checkNonNegative("NumDistinctPermutations()", k); err != nil {
+		return 0, err
+	}
+	if k > len(arr) {
+		return 0, nil
 	}
-	return n * factorial(n-1)
+	if k == len(arr) {
+		counts := []int{}
+		for _, v := range counter.keys {
+			counts = append(counts, counter.Get(v))
+		}
+		return Multinomial(counts...)
+	}
+	// arrangements[j] is the number of orderings of length j using the elements counted so far.
+	// Adding m copies of a new element to an ordering of length j can be done in C(j+m, m) ways.
+	arrangements := make([]*big.Int, k+1)
+	for j := range arrangements {
+		arrangements[j] = big.NewInt(0)
+	}
+	arrangements[0].SetInt64(1)
+	for _, v := range counter.keys {
+		next := make([]*big.Int, k+1)
+		for j := range next {
+			next[j] = big.NewInt(0)
+		}
+		for j, a := range arrangements {
+			if a.Sign() == 0 {
+				continue
+			}
+			for m := 0; m <= counter.Get(v) && j+m <= k; m++ {
+				ways := new(big.Int).Binomial(int64(j+m), int64(m))
+				next[j+m].Add(next[j+m], ways.Mul(ways, a))
+			}
+		}
+		arrangements = next
+	}
+	return bigToInt(arrangements[k], fmt.Sprintf("NumDistinctPermutations(%d)", k))
 }
 
-// Returns the number of permutations of the array
-func NumPermutations[T any](arr []T) int {
-	return factorial(len(arr))
+// Returns an iterator over every subset of the array, from the empty subset up to the whole array.
+// Subsets of the same length are produced in the same order as Combinations.
+func PowerSet[T any](arr []T) *Iterator[[]T] {
+	pool := Copy(arr)
+	length := 0
+	combos := Combinations(pool, length)
+	return NewIterator(func() ([]T, bool) {
+		for !combos.Next() {
+			if length >= len(pool) {
+				return nil, false
+			}
+			length++
+			combos = Combinations(pool, length)
+		}
+		return combos.Value(), true
+	})
 }
 
-// Converts a channel used as a generator to an array
-func GeneratorToArray[T any](c <-chan []T) [][]T {
-	arr := [][]T{}
-	for item := range c {
-		arr = append(arr, item)
+// Converts an iterator of arrays, such as the one returned by Combinations or Permutations, to an array
+func GeneratorToArray[T any](it *Iterator[[]T]) [][]T {
+	return it.ToArray()
+}
+
+// Returns an iterator over the running results of applying f to the values of the iterator,
+// e.g. running totals. If an initial value is provided, it is produced first and used as the
+// starting accumulator.
+func Accumulate[T any](it *Iterator[T], f func(acc T, value T) T, initial ...T) *Iterator[T] {
+	var acc T
+	started := false
+	if len(initial) >= 1 {
+		acc = initial[0]
 	}
-	return arr
+	return NewIterator(func() (T, bool) {
+		if !started && len(initial) >= 1 {
+			started = true
+			return acc, true
+		}
+		if !it.Next() {
+			return acc, false
+		}
+		if started {
+			acc = f(acc, it.Value())
+		} else {
+			acc = it.Value()
+			started = true
+		}
+		return acc, true
+	})
+}
+
+// Returns an iterator that produces the values of each iterator in turn until all are exhausted
+func Chain[T any](its ...*Iterator[T]) *Iterator[T] {
+	return NewIterator(func() (T, bool) {
+		for len(its) > 0 {
+			if its[0].Next() {
+				return its[0].Value(), true
+			}
+			its = its[1:]
+		}
+		var zero T
+		return zero, false
+	})
+}
+
+// Returns an iterator that produces the values of data for which the corresponding selector is true.
+// Stops when either data or selectors is exhausted.
+func Compress[T any](data *Iterator[T], selectors *Iterator[bool]) *Iterator[T] {
+	return NewIterator(func() (T, bool) {
+		for data.Next() && selectors.Next() {
+			if selectors.Value() {
+				return data.Value(), true
+			}
+		}
+		var zero T
+		return zero, false
+	})
+}
+
+// Returns an infinite iterator of evenly spaced numbers beginning with start.
+// The step defaults to 1.
+func CountFrom[T Number](start T, step ...T) *Iterator[T] {
+	increment := T(1)
+	if len(step) >= 1 {
+		increment = step[0]
+	}
+	n := start
+	return NewIterator(func() (T, bool) {
+		value := n
+		n += increment
+		return value, true
+	})
+}
+
+// Returns an iterator that produces the values of the iterator, repeating them indefinitely
+// once it is exhausted
+func Cycle[T any](it *Iterator[T]) *Iterator[T] {
+	saved := []T{}
+	i := -1
+	return NewIterator(func() (T, bool) {
+		if i < 0 {
+			if it.Next() {
+				saved = append(saved, it.Value())
+				return it.Value(), true
+			}
+			if len(saved) == 0 {
+				var zero T
+				return zero, false
+			}
+			i = 0
+		}
+		value := saved[i]
+		i = (i + 1) % len(saved)
+		return value, true
+	})
+}
+
+// Returns an iterator that skips values while the predicate is true, then produces every remaining value
+func DropWhile[T any](it *Iterator[T], predicate func(T) bool) *Iterator[T] {
+	dropping := true
+	return NewIterator(func() (T, bool) {
+		for it.Next() {
+			if dropping && predicate(it.Value()) {
+				continue
+			}
+			dropping = false
+			return it.Value(), true
+		}
+		var zero T
+		return zero, false
+	})
+}
+
+// Returns an iterator that produces the values for which the predicate is false
+func FilterFalse[T any](it *Iterator[T], predicate func(T) bool) *Iterator[T] {
+	return NewIterator(func() (T, bool) {
+		for it.Next() {
+			if !predicate(it.Value()) {
+				return it.Value(), true
+			}
+		}
+		var zero T
+		return zero, false
+	})
+}
+
+// A run of consecutive values sharing the same key, as produced by GroupBy
+type Group[K comparable, T any] struct {
+	Key    K
+	Values *Iterator[T]
+}
+
+// Returns an iterator of groups of consecutive values which share the same key.
+// The values of each group share the underlying iterator, so a group's values are no longer
+// available once the next group has been requested.
+func GroupBy[T any, K comparable](it *Iterator[T], key func(T) K) *Iterator[Group[K, T]] {
+	var current T
+	var currentKey, groupKey K
+	hasCurrent, started := false, false
+	groupID := 0
+	advance := func() {
+		hasCurrent = it.Next()
+		if hasCurrent {
+			current = it.Value()
+			currentKey = key(current)
+		}
+	}
+	return NewIterator(func() (Group[K, T], bool) {
+		if !started {
+			started = true
+			advance()
+		} else {
+			for hasCurrent && currentKey == groupKey {
+				advance()
+			}
+		}
+		if !hasCurrent {
+			return Group[K, T]{}, false
+		}
+		groupID++
+		id := groupID
+		groupKey = currentKey
+		values := NewIterator(func() (T, bool) {
+			if id != groupID || !hasCurrent || currentKey != groupKey {
+				var zero T
+				return zero, false
+			}
+			value := current
+			advance()
+			return value, true
+		})
+		return Group[K, T]{Key: groupKey, Values: values}, true
+	})
+}
+
+// Returns an iterator over selected values of the iterator, following the semantics of Python's
+// itertools.islice. One index is treated as stop; two as start and stop; three as start, stop and step.
+// A stop of -1 continues until the iterator is exhausted. Returns an error for invalid indices.
+func Islice[T any](it *Iterator[T], indices ...int) (*Iterator[T], error) {
+	start, stop, step := 0, -1, 1
+	switch len(indices) {
+	case 1:
+		stop = indices[0]
+	case 2:
+		start, stop = indices[0], indices[1]
+	case 3:
+		start, stop, step = indices[0], indices[1], indices[2]
+	default:
+		return nil, fmt.Errorf("Islice() expected 1 to 3 indices, got %d", len(indices))
+	}
+	if start < 0 || stop < -1 {
+		return nil, errors.New("Islice() indices must be non-negative, or -1 for stop")
+	}
+	if step < 1 {
+		return nil, errors.New("Islice() step must be a positive integer")
+	}
+	i, next := 0, start
+	return NewIterator(func() (T, bool) {
+		var zero T
+		if stop != -1 && next >= stop {
+			return zero, false
+		}
+		for it.Next() {
+			i++
+			if i-1 == next {
+				next += step
+				return it.Value(), true
+			}
+		}
+		return zero, false
+	}), nil
+}
+
+// Returns an iterator over successive overlapping pairs of values from the iterator
+func Pairwise[T any](it *Iterator[T]) *Iterator[[2]T] {
+	var previous T
+	started := false
+	return NewIterator(func() ([2]T, bool) {
+		if !started {
+			started = true
+			if !it.Next() {
+				return [2]T{}, false
+			}
+			previous = it.Value()
+		}
+		if !it.Next() {
+			return [2]T{}, false
+		}
+		pair := [2]T{previous, it.Value()}
+		previous = it.Value()
+		return pair, true
+	})
+}
+
+// Returns an iterator over the cartesian product of the given arrays, equivalent to nested for loops.
+// The rightmost array advances on every iteration.
+func Product[T any](arrs ...[]T) *Iterator[[]T] {
+	pools := make([][]T, len(arrs))
+	for i, arr := range arrs {
+		if len(arr) == 0 {
+			return emptyIterator[[]T]()
+		}
+		pools[i] = Copy(arr)
+	}
+	indices := make([]int, len(pools))
+	started := false
+	return NewIterator(func() ([]T, bool) {
+		if started {
+			i := len(pools) - 1
+			for ; i >= 0; i-- {
+				indices[i]++
+				if indices[i] < len(pools[i]) {
+					break
+				}
+				indices[i] = 0
+			}
+			if i < 0 {
+				return nil, false
+			}
+		}
+		started = true
+		product := make([]T, len(pools))
+		for i, index := range indices {
+			product[i] = pools[i][index]
+		}
+		return product, true
+	})
+}
+
+// Returns an iterator that produces the value the given number of times.
+// If the number of times is omitted or negative, the value is repeated indefinitely.
+func Repeat[T any](value T, times ...int) *Iterator[T] {
+	remaining := -1
+	if len(times) >= 1 && times[0] >= 0 {
+		remaining = times[0]
+	}
+	return NewIterator(func() (T, bool) {
+		if remaining == 0 {
+			var zero T
+			return zero, false
+		}
+		if remaining > 0 {
+			remaining--
+		}
+		return value, true
+	})
+}
+
+// Returns an iterator over the results of calling f with each array of arguments
+func StarMap[T any, V any](it *Iterator[[]T], f func(args ...T) V) *Iterator[V] {
+	return NewIterator(func() (V, bool) {
+		if !it.Next() {
+			var zero V
+			return zero, false
+		}
+		return f(it.Value()...), true
+	})
+}
+
+// Returns an iterator that produces values while the predicate is true
+func TakeWhile[T any](it *Iterator[T], predicate func(T) bool) *Iterator[T] {
+	return NewIterator(func() (T, bool) {
+		if it.Next() && predicate(it.Value()) {
+			return it.Value(), true
+		}
+		var zero T
+		return zero, false
+	})
+}
+
+type teeBuffer[T any] struct {
+	source    *Iterator[T]
+	values    []T
+	offset    int
+	positions []int
+}
+
+func (b *teeBuffer[T]) next(cursor int) (T, bool) {
+	i := b.positions[cursor] - b.offset
+	if i == len(b.values) {
+		if !b.source.Next() {
+			var zero T
+			return zero, false
+		}
+		b.values = append(b.values, b.source.Value())
+	}
+	value := b.values[i]
+	b.positions[cursor]++
+	// Discard values that every cursor has already consumed
+	lowest := b.positions[0]
+	for _, p := range b.positions {
+		if p < lowest {
+			lowest = p
+		}
+	}
+	if drop := lowest - b.offset; drop > 0 {
+		var zero T
+		for j := 0; j < drop; j++ {
+			b.values[j] = zero
+		}
+		b.values = b.values[drop:]
+		b.offset = lowest
+	}
+	return value, true
+}
+
+// Returns n independent iterators over the values of the given iterator. Values are buffered
+// until every returned iterator has consumed them. The original iterator should not be used afterwards.
+func Tee[T any](it *Iterator[T], n int) []*Iterator[T] {
+	if n < 0 {
+		n = 0
+	}
+	buffer := &teeBuffer[T]{source: it, positions: make([]int, n)}
+	its := make([]*Iterator[T], n)
+	for i := range its {
+		cursor := i
+		its[i] = NewIterator(func() (T, bool) {
+			return buffer.next(cursor)
+		})
+	}
+	return its
+}
+
+// Returns an iterator that aggregates values from each of the iterators. Iteration continues until
+// the longest iterator is exhausted, with missing values replaced by the fill value.
+func ZipLongest[T any](fill T, its ...*Iterator[T]) *Iterator[[]T] {
+	active := make([]bool, len(its))
+	for i := range active {
+		active[i] = true
+	}
+	return NewIterator(func() ([]T, bool) {
+		values := make([]T, len(its))
+		remaining := false
+		for i, it := range its {
+			if active[i] && it.Next() {
+				values[i] = it.Value()
+				remaining = true
+			} else {
+				active[i] = false
+				values[i] = fill
+			}
+		}
+		return values, remaining
+	})
 }
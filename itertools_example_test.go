@@ -1,11 +1,15 @@
 package godino
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 func ExampleCombinations() {
 	nums := []int{1, 2, 3}
-	for combo := range Combinations(nums, 2) {
-		fmt.Println(combo)
+	combos := Combinations(nums, 2)
+	for combos.Next() {
+		fmt.Println(combos.Value())
 	}
 	// Output:
 	// [1 2]
@@ -13,6 +17,33 @@ func ExampleCombinations() {
 	// [2 3]
 }
 
+func ExampleCombinationsWithReplacement() {
+	fmt.Println(GeneratorToArray(CombinationsWithReplacement([]string{"A", "B", "C"}, 2)))
+	// Output: [[A A] [A B] [A C] [B B] [B C] [C C]]
+}
+
+func ExampleCombinationsContext() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	combos := CombinationsContext(ctx, []int{1, 2, 3}, 2)
+	for combos.Next() {
+		fmt.Println(combos.Value())
+		cancel()
+	}
+	fmt.Println(combos.Err())
+	// Output:
+	// [1 2]
+	// context canceled
+}
+
+func ExampleDistinctPermutations() {
+	fmt.Println(GeneratorToArray(DistinctPermutations([]int{1, 1, 2}, -1)))
+	fmt.Println(GeneratorToArray(DistinctPermutations([]int{1, 1, 2}, 2)))
+	// Output:
+	// [[1 1 2] [1 2 1] [2 1 1]]
+	// [[1 1] [1 2] [2 1]]
+}
+
 func ExampleGeneratorToArray() {
 	nums := []int{1, 2, 3}
 	combos := Combinations(nums, 2)
@@ -21,22 +52,183 @@ func ExampleGeneratorToArray() {
 	// [[1 2] [1 3] [2 3]]
 }
 
+func ExampleNumCombinations() {
+	nums := []int{1, 2, 3, 4, 5}
+	fmt.Println(NumCombinations(nums, 2))
+	// Output: 10 <nil>
+}
+
+func ExampleNumCombinationsWithReplacement() {
+	nums := []int{1, 2, 3, 4, 5}
+	fmt.Println(NumCombinationsWithReplacement(nums, 2))
+	// Output: 15 <nil>
+}
+
+func ExampleNumDistinctPermutations() {
+	letters := []rune("MISSISSIPPI")
+	fmt.Println(NumDistinctPermutations(letters))
+	fmt.Println(NumDistinctPermutations(letters, 2))
+	// Output:
+	// 34650 <nil>
+	// 15 <nil>
+}
+
 func ExampleNumPermutations() {
 	nums := []int{1, 2, 3, 4, 5}
 	fmt.Println(NumPermutations(nums))
-	// Output: 120
+	fmt.Println(NumPermutations(nums, 2))
+	fmt.Println(NumPermutations(make([]int, 25)))
+	// Output:
+	// 120 <nil>
+	// 20 <nil>
+	// 0 Perm(25, 25): integer overflow
 }
 
 func ExamplePermutations() {
 	nums := []int{1, 2, 3}
-	for p := range Permutations(nums, -1) {
-		fmt.Println(p)
+	perms := Permutations(nums, -1)
+	for perms.Next() {
+		fmt.Println(perms.Value())
 	}
 	// Output:
 	// [1 2 3]
 	// [1 3 2]
 	// [2 1 3]
 	// [2 3 1]
-	// [3 2 1]
 	// [3 1 2]
+	// [3 2 1]
+}
+
+func ExampleAccumulate() {
+	runningTotal := Accumulate(Iter([]int{1, 2, 3, 4, 5}), func(acc, n int) int {
+		return acc + n
+	})
+	fmt.Println(runningTotal.ToArray())
+	// Output: [1 3 6 10 15]
+}
+
+func ExampleChain() {
+	chained := Chain(Iter([]string{"a", "b"}), Iter([]string{"c", "d"}))
+	fmt.Println(chained.ToArray())
+	// Output: [a b c d]
+}
+
+func ExampleCompress() {
+	compressed := Compress(Iter([]string{"a", "b", "c", "d"}), Iter([]bool{true, false, true, true}))
+	fmt.Println(compressed.ToArray())
+	// Output: [a c d]
+}
+
+func ExampleCountFrom() {
+	evens, _ := Islice(CountFrom(0, 2), 5)
+	fmt.Println(evens.ToArray())
+	// Output: [0 2 4 6 8]
+}
+
+func ExampleCycle() {
+	cycled, _ := Islice(Cycle(Iter([]string{"a", "b", "c"})), 7)
+	fmt.Println(cycled.ToArray())
+	// Output: [a b c a b c a]
+}
+
+func ExampleDropWhile() {
+	dropped := DropWhile(Iter([]int{1, 4, 6, 4, 1}), func(n int) bool { return n < 5 })
+	fmt.Println(dropped.ToArray())
+	// Output: [6 4 1]
+}
+
+func ExampleFilterFalse() {
+	odds := FilterFalse(Iter([]int{1, 2, 3, 4, 5}), func(n int) bool { return n%2 == 0 })
+	fmt.Println(odds.ToArray())
+	// Output: [1 3 5]
+}
+
+func ExampleGroupBy() {
+	groups := GroupBy(Iter([]string{"apple", "avocado", "banana", "blueberry", "cherry", "apricot"}), func(s string) byte {
+		return s[0]
+	})
+	for groups.Next() {
+		group := groups.Value()
+		fmt.Println(string(group.Key), group.Values.ToArray())
+	}
+	// Output:
+	// a [apple avocado]
+	// b [banana blueberry]
+	// c [cherry]
+	// a [apricot]
+}
+
+func ExampleIslice() {
+	letters := []string{"A", "B", "C", "D", "E", "F", "G"}
+
+	first2, _ := Islice(Iter(letters), 2)
+	fmt.Println(first2.ToArray())
+
+	middle, _ := Islice(Iter(letters), 2, 4)
+	fmt.Println(middle.ToArray())
+
+	rest, _ := Islice(Iter(letters), 2, -1)
+	fmt.Println(rest.ToArray())
+
+	everyOther, _ := Islice(Iter(letters), 0, -1, 2)
+	fmt.Println(everyOther.ToArray())
+
+	_, err := Islice(Iter(letters), 0, -1, 0)
+	fmt.Println(err)
+	// Output:
+	// [A B]
+	// [C D]
+	// [C D E F G]
+	// [A C E G]
+	// Islice() step must be a positive integer
+}
+
+func ExamplePairwise() {
+	pairs := Pairwise(Iter([]string{"A", "B", "C", "D"}))
+	fmt.Println(pairs.ToArray())
+	// Output: [[A B] [B C] [C D]]
+}
+
+func ExamplePowerSet() {
+	fmt.Println(GeneratorToArray(PowerSet([]int{1, 2, 3})))
+	// Output: [[] [1] [2] [3] [1 2] [1 3] [2 3] [1 2 3]]
+}
+
+func ExampleProduct() {
+	fmt.Println(GeneratorToArray(Product([]string{"A", "B"}, []string{"x", "y"})))
+	// Output: [[A x] [A y] [B x] [B y]]
+}
+
+func ExampleRepeat() {
+	fmt.Println(Repeat("ha", 3).ToArray())
+	// Output: [ha ha ha]
+}
+
+func ExampleStarMap() {
+	sums := StarMap(Iter([][]int{{1, 2}, {3, 4, 5}}), func(args ...int) int {
+		return Sum(args...)
+	})
+	fmt.Println(sums.ToArray())
+	// Output: [3 12]
+}
+
+func ExampleTakeWhile() {
+	taken := TakeWhile(Iter([]int{1, 4, 6, 4, 1}), func(n int) bool { return n < 5 })
+	fmt.Println(taken.ToArray())
+	// Output: [1 4]
+}
+
+func ExampleTee() {
+	its := Tee(Iter([]int{1, 2, 3}), 2)
+	fmt.Println(its[0].ToArray())
+	fmt.Println(its[1].ToArray())
+	// Output:
+	// [1 2 3]
+	// [1 2 3]
+}
+
+func ExampleZipLongest() {
+	zipped := ZipLongest("-", Iter([]string{"A", "B", "C", "D"}), Iter([]string{"x", "y"}))
+	fmt.Println(zipped.ToArray())
+	// Output: [[A x] [B y] [C -] [D -]]
 }
@@ -1,9 +1,13 @@
 package godino
 
 import (
+	"context"
+	"fmt"
+	"runtime"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/exp/slices"
 )
 
 func TestCombinations(t *testing.T) {
@@ -24,10 +28,10 @@ func TestPermutations(t *testing.T) {
 			{1, 3, 2},
 			{2, 1, 3},
 			{2, 3, 1},
-			{3, 2, 1},
 			{3, 1, 2},
+			{3, 2, 1},
 		}
-		assert.ElementsMatch(t, expected, permArry)
+		assert.Equal(t, expected, permArry)
 	})
 
 	t.Run("permutations of a length less than the array", func(t *testing.T) {
@@ -39,9 +43,326 @@ func TestPermutations(t *testing.T) {
 			{1, 3},
 			{2, 1},
 			{2, 3},
-			{3, 2},
 			{3, 1},
+			{3, 2},
+		}
+		assert.Equal(t, expected, permArry)
+	})
+}
+
+func TestCombinationsEdgeCases(t *testing.T) {
+	arr := []int{1, 2, 3}
+	assert.Equal(t, [][]int{{}}, GeneratorToArray(Combinations(arr, 0)))
+	assert.Equal(t, [][]int{{1, 2, 3}}, GeneratorToArray(Combinations(arr, 3)))
+	assert.Equal(t, [][]int{}, GeneratorToArray(Combinations(arr, 4)))
+	assert.Equal(t, [][]int{}, GeneratorToArray(Combinations(arr, -1)))
+}
+
+func TestPermutationsLexicographicOrder(t *testing.T) {
+	t.Run("orderings follow the index order of the input rather than the values", func(t *testing.T) {
+		perms := GeneratorToArray(Permutations([]string{"c", "a", "b"}, 2))
+		expected := [][]string{{"c", "a"}, {"c", "b"}, {"a", "c"}, {"a", "b"}, {"b", "c"}, {"b", "a"}}
+		assert.Equal(t, expected, perms)
+	})
+
+	t.Run("sorted input produces sorted output", func(t *testing.T) {
+		perms := GeneratorToArray(Permutations([]int{1, 2, 3, 4}, -1))
+		assert.Equal(t, 24, len(perms))
+		for i := 1; i < len(perms); i++ {
+			assert.True(t, slices.Compare(perms[i-1], perms[i]) < 0, "%v is not before %v", perms[i-1], perms[i])
+		}
+	})
+
+	t.Run("edge cases", func(t *testing.T) {
+		assert.Equal(t, [][]int{{}}, GeneratorToArray(Permutations([]int{1, 2}, 0)))
+		assert.Equal(t, [][]int{}, GeneratorToArray(Permutations([]int{1, 2}, 3)))
+		assert.Equal(t, [][]int{{}}, GeneratorToArray(Permutations([]int{}, -1)))
+	})
+}
+
+func TestDistinctPermutations(t *testing.T) {
+	t.Run("should produce each unique ordering once in lexicographic order", func(t *testing.T) {
+		perms := GeneratorToArray(DistinctPermutations([]int{1, 1, 2}, -1))
+		assert.Equal(t, [][]int{{1, 1, 2}, {1, 2, 1}, {2, 1, 1}}, perms)
+	})
+
+	t.Run("should match the deduplicated permutations for every length", func(t *testing.T) {
+		arr := []int{1, 2, 2, 3, 3, 3}
+		for length := 0; length <= len(arr); length++ {
+			expected := [][]int{}
+			seen := NewSet[string]()
+			for _, p := range GeneratorToArray(Permutations(arr, length)) {
+				key := fmt.Sprint(p)
+				if !seen.Has(key) {
+					seen.Add(key)
+					expected = append(expected, p)
+				}
+			}
+			actual := GeneratorToArray(DistinctPermutations(arr, length))
+			assert.Equal(t, expected, actual, length)
+
+			n, err := NumDistinctPermutations(arr, length)
+			assert.NoError(t, err)
+			assert.Equal(t, len(expected), n, length)
+		}
+	})
+
+	t.Run("equal elements rank by first occurrence", func(t *testing.T) {
+		perms := GeneratorToArray(DistinctPermutations([]string{"b", "a", "b"}, -1))
+		assert.Equal(t, [][]string{{"b", "b", "a"}, {"b", "a", "b"}, {"a", "b", "b"}}, perms)
+	})
+
+	t.Run("edge cases", func(t *testing.T) {
+		assert.Equal(t, [][]int{{}}, GeneratorToArray(DistinctPermutations([]int{}, -1)))
+		assert.Equal(t, [][]int{}, GeneratorToArray(DistinctPermutations([]int{1}, 2)))
+		assert.Equal(t, [][]int{{5, 5, 5}}, GeneratorToArray(DistinctPermutations([]int{5, 5, 5}, -1)))
+	})
+}
+
+func TestNumDistinctPermutations(t *testing.T) {
+	n, err := NumDistinctPermutations([]rune("MISSISSIPPI"))
+	assert.NoError(t, err)
+	assert.Equal(t, 34650, n)
+
+	n, err = NumDistinctPermutations([]int{1, 2}, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, n)
+
+	_, err = NumDistinctPermutations([]int{1, 2}, -2)
+	assert.Error(t, err)
+
+	arr := make([]int, 30)
+	for i := range arr {
+		arr[i] = i
+	}
+	_, err = NumDistinctPermutations(arr, 25)
+	assert.ErrorIs(t, err, ErrOverflow)
+}
+
+func TestPermutationsDoesNotModifyArray(t *testing.T) {
+	arr := []int{1, 2, 3}
+	GeneratorToArray(Permutations(arr, -1))
+	assert.Equal(t, []int{1, 2, 3}, arr)
+}
+
+func TestEarlyTerminationDoesNotLeak(t *testing.T) {
+	before := runtime.NumGoroutine()
+	for i := 0; i < 100; i++ {
+		combos := Combinations([]int{1, 2, 3, 4}, 2)
+		combos.Next()
+		perms := Permutations([]int{1, 2, 3, 4}, -1)
+		perms.Next()
+	}
+	assert.LessOrEqual(t, runtime.NumGoroutine(), before)
+}
+
+func TestPermutationsContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	perms := PermutationsContext(ctx, []int{1, 2, 3}, -1)
+	assert.True(t, perms.Next())
+	assert.True(t, perms.Next())
+	cancel()
+	assert.False(t, perms.Next())
+	assert.ErrorIs(t, perms.Err(), context.Canceled)
+}
+
+func TestCombinationsWithReplacement(t *testing.T) {
+	expected := [][]string{{"A", "A"}, {"A", "B"}, {"A", "C"}, {"B", "B"}, {"B", "C"}, {"C", "C"}}
+	assert.Equal(t, expected, GeneratorToArray(CombinationsWithReplacement([]string{"A", "B", "C"}, 2)))
+	assert.Equal(t, [][]int{{}}, GeneratorToArray(CombinationsWithReplacement([]int{1, 2}, 0)))
+	assert.Equal(t, [][]int{}, GeneratorToArray(CombinationsWithReplacement([]int{}, 2)))
+	assert.Equal(t, [][]int{{1, 1, 1}}, GeneratorToArray(CombinationsWithReplacement([]int{1}, 3)))
+}
+
+func TestNumCombinations(t *testing.T) {
+	arr := []int{1, 2, 3, 4, 5}
+	for length := 0; length <= 6; length++ {
+		n, err := NumCombinations(arr, length)
+		assert.NoError(t, err)
+		assert.Equal(t, len(GeneratorToArray(Combinations(arr, length))), n, length)
+
+		n, err = NumCombinationsWithReplacement(arr, length)
+		assert.NoError(t, err)
+		assert.Equal(t, len(GeneratorToArray(CombinationsWithReplacement(arr, length))), n, length)
+	}
+	n, _ := NumCombinationsWithReplacement([]int{}, 0)
+	assert.Equal(t, 1, n)
+	n, _ = NumCombinationsWithReplacement([]int{}, 1)
+	assert.Equal(t, 0, n)
+
+	_, err := NumCombinations(arr, -1)
+	assert.Error(t, err)
+}
+
+func TestNumPermutations(t *testing.T) {
+	arr := []int{1, 2, 3, 4, 5}
+	for length := 0; length <= 6; length++ {
+		n, err := NumPermutations(arr, length)
+		assert.NoError(t, err)
+		assert.Equal(t, len(GeneratorToArray(Permutations(arr, length))), n, length)
+	}
+
+	n, err := NumPermutations(arr)
+	assert.NoError(t, err)
+	assert.Equal(t, 120, n)
+
+	n, err = NumPermutations(arr, -1)
+	assert.NoError(t, err)
+	assert.Equal(t, 120, n)
+
+	_, err = NumPermutations(make([]int, 21))
+	assert.ErrorIs(t, err, ErrOverflow)
+
+	n, err = NumPermutations(make([]int, 100), 2)
+	assert.NoError(t, err)
+	assert.Equal(t, 9900, n)
+}
+
+func TestPowerSet(t *testing.T) {
+	expected := [][]int{{}, {1}, {2}, {3}, {1, 2}, {1, 3}, {2, 3}, {1, 2, 3}}
+	assert.Equal(t, expected, GeneratorToArray(PowerSet([]int{1, 2, 3})))
+	assert.Equal(t, [][]int{{}}, GeneratorToArray(PowerSet([]int{})))
+}
+
+func TestAccumulate(t *testing.T) {
+	add := func(acc, v int) int { return acc + v }
+	assert.Equal(t, []int{1, 3, 6, 10}, Accumulate(Iter([]int{1, 2, 3, 4}), add).ToArray())
+	assert.Equal(t, []int{100, 101, 103}, Accumulate(Iter([]int{1, 2}), add, 100).ToArray())
+	assert.Equal(t, []int{}, Accumulate(Iter([]int{}), add).ToArray())
+}
+
+func TestChain(t *testing.T) {
+	chained := Chain(Iter([]int{1, 2}), Iter([]int{}), Iter([]int{3}))
+	assert.Equal(t, []int{1, 2, 3}, chained.ToArray())
+	assert.Equal(t, []int{}, Chain[int]().ToArray())
+}
+
+func TestCompress(t *testing.T) {
+	data := Iter([]string{"a", "b", "c", "d"})
+	selectors := Iter([]bool{true, false, true})
+	assert.Equal(t, []string{"a", "c"}, Compress(data, selectors).ToArray())
+}
+
+func TestCountFrom(t *testing.T) {
+	it, _ := Islice(CountFrom(10), 3)
+	assert.Equal(t, []int{10, 11, 12}, it.ToArray())
+	it2, _ := Islice(CountFrom(1.0, 0.5), 3)
+	assert.Equal(t, []float64{1, 1.5, 2}, it2.ToArray())
+}
+
+func TestCycle(t *testing.T) {
+	it, _ := Islice(Cycle(Iter([]int{1, 2, 3})), 7)
+	assert.Equal(t, []int{1, 2, 3, 1, 2, 3, 1}, it.ToArray())
+	assert.Equal(t, []int{}, Cycle(Iter([]int{})).ToArray())
+}
+
+func TestDropWhileTakeWhile(t *testing.T) {
+	lessThan5 := func(n int) bool { return n < 5 }
+	assert.Equal(t, []int{6, 4, 1}, DropWhile(Iter([]int{1, 4, 6, 4, 1}), lessThan5).ToArray())
+	assert.Equal(t, []int{1, 4}, TakeWhile(Iter([]int{1, 4, 6, 4, 1}), lessThan5).ToArray())
+	assert.Equal(t, []int{6}, FilterFalse(Iter([]int{1, 4, 6, 4, 1}), lessThan5).ToArray())
+}
+
+func TestGroupBy(t *testing.T) {
+	t.Run("should group consecutive values by key", func(t *testing.T) {
+		groups := GroupBy(Iter([]rune("AAAABBBCCDAABBB")), func(r rune) rune { return r })
+		keys := []string{}
+		values := []string{}
+		for groups.Next() {
+			g := groups.Value()
+			keys = append(keys, string(g.Key))
+			values = append(values, string(g.Values.ToArray()))
 		}
-		assert.ElementsMatch(t, expected, permArry)
+		assert.Equal(t, []string{"A", "B", "C", "D", "A", "B"}, keys)
+		assert.Equal(t, []string{"AAAA", "BBB", "CC", "D", "AA", "BBB"}, values)
 	})
+
+	t.Run("should skip unconsumed values and invalidate previous groups", func(t *testing.T) {
+		groups := GroupBy(Iter([]int{1, 3, 2, 4, 5}), func(n int) bool { return n%2 == 0 })
+		groups.Next()
+		first := groups.Value()
+		assert.True(t, first.Values.Next())
+		groups.Next()
+		second := groups.Value()
+		assert.True(t, second.Key)
+		assert.False(t, first.Values.Next())
+		assert.Equal(t, []int{2, 4}, second.Values.ToArray())
+		groups.Next()
+		assert.Equal(t, []int{5}, groups.Value().Values.ToArray())
+		assert.False(t, groups.Next())
+	})
+}
+
+func TestIslice(t *testing.T) {
+	letters := []string{"A", "B", "C", "D", "E", "F", "G"}
+	cases := []struct {
+		indices  []int
+		expected []string
+	}{
+		{[]int{2}, []string{"A", "B"}},
+		{[]int{2, 4}, []string{"C", "D"}},
+		{[]int{2, -1}, []string{"C", "D", "E", "F", "G"}},
+		{[]int{0, -1, 2}, []string{"A", "C", "E", "G"}},
+		{[]int{1, 6, 2}, []string{"B", "D", "F"}},
+		{[]int{0}, []string{}},
+	}
+	for _, c := range cases {
+		it, err := Islice(Iter(letters), c.indices...)
+		assert.NoError(t, err)
+		assert.Equal(t, c.expected, it.ToArray(), c.indices)
+	}
+
+	_, err := Islice(Iter(letters), 0, 5, 0)
+	assert.Error(t, err)
+	_, err = Islice(Iter(letters), -1, 5)
+	assert.Error(t, err)
+	_, err = Islice(Iter(letters))
+	assert.Error(t, err)
+}
+
+func TestPairwise(t *testing.T) {
+	assert.Equal(t, [][2]int{{1, 2}, {2, 3}, {3, 4}}, Pairwise(Iter([]int{1, 2, 3, 4})).ToArray())
+	assert.Equal(t, [][2]int{}, Pairwise(Iter([]int{1})).ToArray())
+}
+
+func TestProduct(t *testing.T) {
+	expected := [][]int{{1, 3}, {1, 4}, {2, 3}, {2, 4}}
+	assert.Equal(t, expected, GeneratorToArray(Product([]int{1, 2}, []int{3, 4})))
+	assert.Equal(t, [][]int{{}}, GeneratorToArray(Product[int]()))
+	assert.Equal(t, [][]int{}, GeneratorToArray(Product([]int{1, 2}, []int{})))
+}
+
+func TestRepeat(t *testing.T) {
+	assert.Equal(t, []string{"a", "a", "a"}, Repeat("a", 3).ToArray())
+	assert.Equal(t, []string{}, Repeat("a", 0).ToArray())
+	it, _ := Islice(Repeat("a"), 5)
+	assert.Equal(t, 5, len(it.ToArray()))
+}
+
+func TestStarMap(t *testing.T) {
+	pow := func(args ...int) int {
+		result := 1
+		for i := 0; i < args[1]; i++ {
+			result *= args[0]
+		}
+		return result
+	}
+	assert.Equal(t, []int{32, 9, 1000}, StarMap(Iter([][]int{{2, 5}, {3, 2}, {10, 3}}), pow).ToArray())
+}
+
+func TestTee(t *testing.T) {
+	its := Tee(Iter([]int{1, 2, 3, 4}), 3)
+	assert.True(t, its[0].Next())
+	assert.True(t, its[0].Next())
+	assert.Equal(t, 2, its[0].Value())
+	assert.Equal(t, []int{1, 2, 3, 4}, its[1].ToArray())
+	assert.Equal(t, []int{3, 4}, its[0].ToArray())
+	assert.Equal(t, []int{1, 2, 3, 4}, its[2].ToArray())
+	assert.Empty(t, Tee(Iter([]int{1}), 0))
+}
+
+func TestZipLongest(t *testing.T) {
+	zipped := ZipLongest("-", Iter([]string{"A", "B", "C", "D"}), Iter([]string{"x", "y"}))
+	expected := [][]string{{"A", "x"}, {"B", "y"}, {"C", "-"}, {"D", "-"}}
+	assert.Equal(t, expected, zipped.ToArray())
 }
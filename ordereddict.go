@@ -0,0 +1,251 @@
+package godino
+
+import (
+	"errors"
+	"fmt"
+)
+
+type orderedDictNode[K comparable, V any] struct {
+	key        K
+	value      V
+	prev, next *orderedDictNode[K, V]
+}
+
+// A dictionary which remembers the order in which keys were first inserted. Lookups, insertions and
+// deletions are O(1). The zero value is an empty dictionary ready to use.
+type OrderedDict[K comparable, V any] struct {
+	nodes map[K]*orderedDictNode[K, V]
+	// root is a sentinel: root.next is the first node and root.prev is the last. It is allocated
+	// separately so that copies of the dictionary share the same list, as copies of a map do.
+	root *orderedDictNode[K, V]
+}
+
+// Returns a new ordered dictionary containing the given items, in order
+func NewOrderedDict[K comparable, V any](items ...DictItem[K, V]) *OrderedDict[K, V] {
+	d := &OrderedDict[K, V]{}
+	for _, item := range items {
+		d.Set(item.Key, item.Value)
+	}
+	return d
+}
+
+// Initializes the zero value. Only methods which add keys call it, so that reading a zero value
+// dictionary never writes to it and concurrent reads are safe.
+func (d *OrderedDict[K, V]) lazyInit() {
+	if d.root == nil {
+		d.nodes = make(map[K]*orderedDictNode[K, V])
+		d.root = &orderedDictNode[K, V]{}
+		d.root.next = d.root
+		d.root.prev = d.root
+	}
+}
+
+// Calls f with each node in insertion order
+func (d *OrderedDict[K, V]) each(f func(node *orderedDictNode[K, V])) {
+	if d.root == nil {
+		return
+	}
+	for node := d.root.next; node != d.root; node = node.next {
+		f(node)
+	}
+}
+
+func (d *OrderedDict[K, V]) unlink(node *orderedDictNode[K, V]) {
+	node.prev.next = node.next
+	node.next.prev = node.prev
+	node.prev, node.next = nil, nil
+}
+
+func (d *OrderedDict[K, V]) linkAfter(node, at *orderedDictNode[K, V]) {
+	node.prev = at
+	node.next = at.next
+	at.next.prev = node
+	at.next = node
+}
+
+// Removes all elements from the dictionary
+func (d *OrderedDict[K, V]) Clear() {
+	d.nodes = nil
+	d.root = nil
+	d.lazyInit()
+}
+
+// Returns a copy of the dictionary
+func (d *OrderedDict[K, V]) Copy() *OrderedDict[K, V] {
+	return NewOrderedDict(d.Items()...)
+}
+
+// Removes the given key from the dictionary. Returns true if the key was present.
+func (d *OrderedDict[K, V]) Delete(key K) bool {
+	node, ok := d.nodes[key]
+	if ok {
+		d.unlink(node)
+		delete(d.nodes, key)
+	}
+	return ok
+}
+
+// Returns true if the dictionaries contain the same items in the same order, comparing values with
+// the given function
+func (d *OrderedDict[K, V]) EqualFunc(d2 *OrderedDict[K, V], equal func(V, V) bool) bool {
+	if d.Len() != d2.Len() {
+		return false
+	}
+	if d.root == nil || d2.root == nil {
+		return true
+	}
+	for n1, n2 := d.root.next, d2.root.next; n1 != d.root; n1, n2 = n1.next, n2.next {
+		if n1.key != n2.key || !equal(n1.value, n2.value) {
+			return false
+		}
+	}
+	return true
+}
+
+// Returns the value associated with given key.
+// In the case the the key is not present, a fallback value is returned if provided.
+// Otherwise the zero-value for the value type is returned.
+func (d *OrderedDict[K, V]) Get(key K, fallback ...V) V {
+	node, ok := d.nodes[key]
+	if !ok {
+		var value V
+		if len(fallback) >= 1 {
+			value = fallback[0]
+		}
+		return value
+	}
+	return node.value
+}
+
+// Returns true if the dictionary contains the given key.
+func (d *OrderedDict[K, V]) Has(key K) bool {
+	_, ok := d.nodes[key]
+	return ok
+}
+
+// Returns an array of dictionary items (a struct with Key and Value fields) in insertion order
+func (d *OrderedDict[K, V]) Items() []DictItem[K, V] {
+	items := make([]DictItem[K, V], 0, len(d.nodes))
+	d.each(func(node *orderedDictNode[K, V]) {
+		items = append(items, DictItem[K, V]{Key: node.key, Value: node.value})
+	})
+	return items
+}
+
+// Returns an array of keys present in the dictionary in insertion order
+func (d *OrderedDict[K, V]) Keys() []K {
+	keys := make([]K, 0, len(d.nodes))
+	d.each(func(node *orderedDictNode[K, V]) {
+		keys = append(keys, node.key)
+	})
+	return keys
+}
+
+// Returns the number of items in the dictionary
+func (d *OrderedDict[K, V]) Len() int {
+	return len(d.nodes)
+}
+
+// Moves an existing key to the end of the dictionary, or to the beginning if last is false.
+// Returns an error if the key is not present.
+func (d *OrderedDict[K, V]) MoveToEnd(key K, last bool) error {
+	node, ok := d.nodes[key]
+	if !ok {
+		return fmt.Errorf("MoveToEnd() key %v not found", key)
+	}
+	d.unlink(node)
+	if last {
+		d.linkAfter(node, d.root.prev)
+	} else {
+		d.linkAfter(node, d.root)
+	}
+	return nil
+}
+
+// Removes the given key from the dictionary and returns it's associated value.
+// If the key is not present in the dictionary, a fallback is returned if provided.
+// Otherwise a zero-value is returned.
+// Returns a second boolean value which is true if the key was present in the dictionary.
+func (d *OrderedDict[K, V]) Pop(key K, fallback ...V) (V, bool) {
+	node, ok := d.nodes[key]
+	if !ok {
+		var value V
+		if len(fallback) >= 1 {
+			value = fallback[0]
+		}
+		return value, false
+	}
+	d.Delete(key)
+	return node.value, true
+}
+
+// Removes and returns the last item of the dictionary, or the first item if last is false.
+// Returns an error if the dictionary is empty.
+func (d *OrderedDict[K, V]) PopItem(last bool) (DictItem[K, V], error) {
+	if len(d.nodes) == 0 {
+		return DictItem[K, V]{}, errors.New("PopItem() called on an empty dictionary")
+	}
+	node := d.root.next
+	if last {
+		node = d.root.prev
+	}
+	d.Delete(node.key)
+	return DictItem[K, V]{Key: node.key, Value: node.value}, nil
+}
+
+// Sets the value for the given key. New keys are added to the end of the dictionary, while
+// existing keys keep their position.
+func (d *OrderedDict[K, V]) Set(key K, value V) {
+	d.lazyInit()
+	if node, ok := d.nodes[key]; ok {
+		node.value = value
+		return
+	}
+	node := &orderedDictNode[K, V]{key: key, value: value}
+	d.nodes[key] = node
+	d.linkAfter(node, d.root.prev)
+}
+
+// Sets the value for the given key if the key is not already present.
+// Returns the value for the given key.
+func (d *OrderedDict[K, V]) SetDefault(key K, value V) V {
+	d.lazyInit()
+	if !d.Has(key) {
+		d.Set(key, value)
+	}
+	return d.nodes[key].value
+}
+
+func (d *OrderedDict[K, V]) String() string {
+	return fmt.Sprintf("%v", d.Items())
+}
+
+// Returns an unordered Dict containing the items of the dictionary
+func (d *OrderedDict[K, V]) ToDict() Dict[K, V] {
+	dict := make(Dict[K, V], d.Len())
+	for _, item := range d.Items() {
+		dict[item.Key] = item.Value
+	}
+	return dict
+}
+
+// Updates the keys and values from the given items, in order
+func (d *OrderedDict[K, V]) Update(items ...DictItem[K, V]) {
+	for _, item := range items {
+		d.Set(item.Key, item.Value)
+	}
+}
+
+// Returns an array of the values of the dictionary in insertion order
+func (d *OrderedDict[K, V]) Values() []V {
+	values := make([]V, 0, len(d.nodes))
+	d.each(func(node *orderedDictNode[K, V]) {
+		values = append(values, node.value)
+	})
+	return values
+}
+
+// Returns true if the dictionaries contain the same items in the same order
+func OrderedDictsEqual[K, V comparable](d1, d2 *OrderedDict[K, V]) bool {
+	return d1.EqualFunc(d2, func(v1, v2 V) bool { return v1 == v2 })
+}
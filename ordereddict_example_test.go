@@ -0,0 +1,58 @@
+package godino
+
+import "fmt"
+
+func ExampleOrderedDict() {
+	d := NewOrderedDict[string, int]()
+	d.Set("b", 2)
+	d.Set("a", 1)
+	d.Set("c", 3)
+	d.Set("b", 4)
+	fmt.Println(d.Keys())
+	fmt.Println(d.Values())
+	// Output:
+	// [b a c]
+	// [4 1 3]
+}
+
+func ExampleOrderedDict_MoveToEnd() {
+	d := NewOrderedDict(
+		DictItem[string, int]{Key: "a", Value: 1},
+		DictItem[string, int]{Key: "b", Value: 2},
+		DictItem[string, int]{Key: "c", Value: 3},
+	)
+	d.MoveToEnd("a", true)
+	fmt.Println(d.Keys())
+	d.MoveToEnd("c", false)
+	fmt.Println(d.Keys())
+	fmt.Println(d.MoveToEnd("z", true))
+	// Output:
+	// [b c a]
+	// [c b a]
+	// MoveToEnd() key z not found
+}
+
+func ExampleOrderedDict_PopItem() {
+	d := NewOrderedDict(
+		DictItem[string, int]{Key: "a", Value: 1},
+		DictItem[string, int]{Key: "b", Value: 2},
+	)
+	fmt.Println(d.PopItem(true))
+	fmt.Println(d.PopItem(false))
+	fmt.Println(d.PopItem(false))
+	// Output:
+	// {b 2} <nil>
+	// {a 1} <nil>
+	// { 0} PopItem() called on an empty dictionary
+}
+
+func ExampleOrderedDictsEqual() {
+	d1 := NewOrderedDict(DictItem[string, int]{Key: "a", Value: 1}, DictItem[string, int]{Key: "b", Value: 2})
+	d2 := NewOrderedDict(DictItem[string, int]{Key: "b", Value: 2}, DictItem[string, int]{Key: "a", Value: 1})
+	fmt.Println(OrderedDictsEqual(d1, d2))
+	d2.MoveToEnd("b", true)
+	fmt.Println(OrderedDictsEqual(d1, d2))
+	// Output:
+	// false
+	// true
+}
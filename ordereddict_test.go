@@ -0,0 +1,185 @@
+package godino
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func getOrderedDict() *OrderedDict[string, int] {
+	return NewOrderedDict(
+		DictItem[string, int]{Key: "apple", Value: 5},
+		DictItem[string, int]{Key: "banana", Value: 3},
+		DictItem[string, int]{Key: "orange", Value: 2},
+	)
+}
+
+func TestOrderedDict(t *testing.T) {
+	t.Run("should preserve insertion order", func(t *testing.T) {
+		d := getOrderedDict()
+		d.Set("cherry", 7)
+		d.Set("apple", 1)
+		assert.Equal(t, []string{"apple", "banana", "orange", "cherry"}, d.Keys())
+		assert.Equal(t, []int{1, 3, 2, 7}, d.Values())
+		assert.Equal(t, 4, d.Len())
+	})
+
+	t.Run("should be usable as a zero value", func(t *testing.T) {
+		var d OrderedDict[string, int]
+		assert.Equal(t, 0, d.Len())
+		assert.False(t, d.Has("apple"))
+		assert.Empty(t, d.Items())
+		d.Set("apple", 1)
+		assert.Equal(t, []string{"apple"}, d.Keys())
+	})
+
+	t.Run("should share state between copies of the value", func(t *testing.T) {
+		var d OrderedDict[string, int]
+		d.Set("apple", 1)
+		d2 := d
+		d2.Set("banana", 2)
+		assert.Equal(t, []string{"apple", "banana"}, d.Keys())
+		assert.Equal(t, []string{"apple", "banana"}, d2.Keys())
+		assert.NoError(t, d.MoveToEnd("apple", true))
+		assert.Equal(t, []string{"banana", "apple"}, d2.Keys())
+		assert.True(t, OrderedDictsEqual(&d, &d2))
+	})
+
+	t.Run("should not modify a zero value when reading", func(t *testing.T) {
+		var d OrderedDict[string, int]
+		var wg sync.WaitGroup
+		for i := 0; i < 4; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				assert.False(t, d.Has("apple"))
+				assert.Equal(t, 0, d.Get("apple"))
+				assert.Empty(t, d.Keys())
+				assert.Empty(t, d.Values())
+				assert.Empty(t, d.Items())
+				assert.Equal(t, "[]", d.String())
+				assert.True(t, OrderedDictsEqual(&d, &OrderedDict[string, int]{}))
+			}()
+		}
+		wg.Wait()
+		_, ok := d.Pop("apple")
+		assert.False(t, ok)
+		assert.False(t, d.Delete("apple"))
+		assert.Error(t, d.MoveToEnd("apple", true))
+		_, err := d.PopItem(true)
+		assert.Error(t, err)
+		assert.Nil(t, d.root)
+	})
+
+	t.Run("should clear the dictionary", func(t *testing.T) {
+		d := getOrderedDict()
+		d.Clear()
+		assert.Equal(t, 0, d.Len())
+		assert.Empty(t, d.Keys())
+		d.Set("mango", 1)
+		assert.Equal(t, []string{"mango"}, d.Keys())
+	})
+
+	t.Run("should return an independent copy of the dictionary", func(t *testing.T) {
+		d := getOrderedDict()
+		c := d.Copy()
+		assert.Equal(t, d.Items(), c.Items())
+		c.Set("mango", 1)
+		assert.False(t, d.Has("mango"))
+	})
+
+	t.Run("should delete keys", func(t *testing.T) {
+		d := getOrderedDict()
+		assert.True(t, d.Delete("banana"))
+		assert.False(t, d.Delete("banana"))
+		assert.Equal(t, []string{"apple", "orange"}, d.Keys())
+		d.Set("banana", 4)
+		assert.Equal(t, []string{"apple", "orange", "banana"}, d.Keys())
+	})
+
+	t.Run("should retrieve the value for the key if it exists else the given fallback value", func(t *testing.T) {
+		d := getOrderedDict()
+		assert.Equal(t, 5, d.Get("apple", 0))
+		assert.Equal(t, 10, d.Get("mango", 10))
+		assert.Equal(t, 0, d.Get("mango"))
+	})
+
+	t.Run("should move keys to either end", func(t *testing.T) {
+		d := getOrderedDict()
+		assert.NoError(t, d.MoveToEnd("apple", true))
+		assert.Equal(t, []string{"banana", "orange", "apple"}, d.Keys())
+		assert.NoError(t, d.MoveToEnd("orange", false))
+		assert.Equal(t, []string{"orange", "banana", "apple"}, d.Keys())
+		assert.NoError(t, d.MoveToEnd("apple", true))
+		assert.Equal(t, []string{"orange", "banana", "apple"}, d.Keys())
+		assert.Error(t, d.MoveToEnd("mango", true))
+	})
+
+	t.Run("should pop keys", func(t *testing.T) {
+		d := getOrderedDict()
+		value, ok := d.Pop("banana")
+		assert.Equal(t, 3, value)
+		assert.True(t, ok)
+		value, ok = d.Pop("banana", 10)
+		assert.Equal(t, 10, value)
+		assert.False(t, ok)
+		assert.Equal(t, []string{"apple", "orange"}, d.Keys())
+	})
+
+	t.Run("should pop items from either end", func(t *testing.T) {
+		d := getOrderedDict()
+		item, err := d.PopItem(true)
+		assert.NoError(t, err)
+		assert.Equal(t, DictItem[string, int]{Key: "orange", Value: 2}, item)
+		item, err = d.PopItem(false)
+		assert.NoError(t, err)
+		assert.Equal(t, DictItem[string, int]{Key: "apple", Value: 5}, item)
+		item, err = d.PopItem(false)
+		assert.NoError(t, err)
+		assert.Equal(t, DictItem[string, int]{Key: "banana", Value: 3}, item)
+		_, err = d.PopItem(true)
+		assert.Error(t, err)
+	})
+
+	t.Run("should set a default value", func(t *testing.T) {
+		d := getOrderedDict()
+		assert.Equal(t, 5, d.SetDefault("apple", 10))
+		assert.Equal(t, 10, d.SetDefault("mango", 10))
+		assert.Equal(t, []string{"apple", "banana", "orange", "mango"}, d.Keys())
+	})
+
+	t.Run("should update the dictionary in order", func(t *testing.T) {
+		d := getOrderedDict()
+		d.Update(DictItem[string, int]{Key: "mango", Value: 1}, DictItem[string, int]{Key: "apple", Value: 2})
+		assert.Equal(t, []DictItem[string, int]{
+			{Key: "apple", Value: 2},
+			{Key: "banana", Value: 3},
+			{Key: "orange", Value: 2},
+			{Key: "mango", Value: 1},
+		}, d.Items())
+	})
+
+	t.Run("should convert to an unordered dictionary", func(t *testing.T) {
+		assert.Equal(t, getDict(), getOrderedDict().ToDict())
+	})
+
+	t.Run("should compare dictionaries taking order into account", func(t *testing.T) {
+		d1 := getOrderedDict()
+		d2 := getOrderedDict()
+		assert.True(t, OrderedDictsEqual(d1, d2))
+		assert.NoError(t, d2.MoveToEnd("apple", true))
+		assert.False(t, OrderedDictsEqual(d1, d2))
+		assert.Equal(t, d1.ToDict(), d2.ToDict())
+		d2.Set("apple", 6)
+		assert.NoError(t, d2.MoveToEnd("apple", false))
+		assert.False(t, OrderedDictsEqual(d1, d2))
+		assert.True(t, d1.EqualFunc(d2, func(a, b int) bool { return a/10 == b/10 }))
+		assert.False(t, OrderedDictsEqual(d1, NewOrderedDict[string, int]()))
+		assert.True(t, OrderedDictsEqual(&OrderedDict[string, int]{}, NewOrderedDict[string, int]()))
+	})
+
+	t.Run("should format the dictionary as a string", func(t *testing.T) {
+		assert.Equal(t, "[{apple 5} {banana 3} {orange 2}]", getOrderedDict().String())
+	})
+}
@@ -0,0 +1,177 @@
+package godino
+
+import (
+	"errors"
+	"fmt"
+)
+
+// A set which remembers the order in which elements were first added. Lookups, insertions and
+// deletions are O(1). The zero value is an empty set ready to use.
+type OrderedSet[T comparable] struct {
+	dict OrderedDict[T, struct{}]
+}
+
+// Returns a new ordered set containing the values provided, in order
+func NewOrderedSet[T comparable](values ...T) *OrderedSet[T] {
+	set := &OrderedSet[T]{}
+	set.Add(values...)
+	return set
+}
+
+// Adds element(s) to the end of the set. Elements already present keep their position.
+func (set *OrderedSet[T]) Add(values ...T) {
+	for _, v := range values {
+		set.dict.Set(v, struct{}{})
+	}
+}
+
+// Removes all the elements from the set
+func (set *OrderedSet[T]) Clear() {
+	set.dict.Clear()
+}
+
+// Returns a copy of the set
+func (set *OrderedSet[T]) Copy() *OrderedSet[T] {
+	return NewOrderedSet(set.Members()...)
+}
+
+// Returns a set containing the elements of this set which are not in any of the given sets,
+// in the order of this set
+func (set *OrderedSet[T]) Difference(sets ...*OrderedSet[T]) *OrderedSet[T] {
+	difference := set.Copy()
+	difference.DifferenceUpdate(sets...)
+	return difference
+}
+
+// Removes the items in this set that are also included in one or more other sets
+func (set *OrderedSet[T]) DifferenceUpdate(sets ...*OrderedSet[T]) {
+	for _, s := range sets {
+		for _, value := range s.Members() {
+			set.Discard(value)
+		}
+	}
+}
+
+// Remove the specified item. If the item is not present this is a noop
+func (set *OrderedSet[T]) Discard(value T) {
+	set.dict.Delete(value)
+}
+
+// Returns true if the sets contain the same members in the same order.
+// Use ToSet().Equals() to compare regardless of order.
+func (set *OrderedSet[T]) Equals(set2 *OrderedSet[T]) bool {
+	return set.dict.EqualFunc(&set2.dict, func(struct{}, struct{}) bool { return true })
+}
+
+// Returns true if the set contains the specified element
+func (set *OrderedSet[T]) Has(value T) bool {
+	return set.dict.Has(value)
+}
+
+// Returns a set containing the elements of this set which are present in all of the given sets,
+// in the order of this set
+func (set *OrderedSet[T]) Intersection(sets ...*OrderedSet[T]) *OrderedSet[T] {
+	intersection := set.Copy()
+	intersection.IntersectionUpdate(sets...)
+	return intersection
+}
+
+// Removes the items in this set that are not present in other, specified set(s)
+func (set *OrderedSet[T]) IntersectionUpdate(sets ...*OrderedSet[T]) {
+	for _, value := range set.Members() {
+		for _, s := range sets {
+			if !s.Has(value) {
+				set.Discard(value)
+				break
+			}
+		}
+	}
+}
+
+// Returns true if the sets have no common elements
+func (set1 *OrderedSet[T]) IsDisjoint(set2 *OrderedSet[T]) bool {
+	for _, value := range set1.Members() {
+		if set2.Has(value) {
+			return false
+		}
+	}
+	return true
+}
+
+// Returns true if the set is contained by the given set
+func (set1 *OrderedSet[T]) IsSubset(set2 *OrderedSet[T]) bool {
+	for _, value := range set1.Members() {
+		if !set2.Has(value) {
+			return false
+		}
+	}
+	return true
+}
+
+// Returns true is the set contains the given set
+func (set1 *OrderedSet[T]) IsSuperset(set2 *OrderedSet[T]) bool {
+	return set2.IsSubset(set1)
+}
+
+// Returns the number of elements in the set
+func (set *OrderedSet[T]) Len() int {
+	return set.dict.Len()
+}
+
+// Returns the elements contained by the set in insertion order
+func (set *OrderedSet[T]) Members() []T {
+	return set.dict.Keys()
+}
+
+// Moves an existing element to the end of the set, or to the beginning if last is false.
+// Returns an error if the element is not present.
+func (set *OrderedSet[T]) MoveToEnd(value T, last bool) error {
+	if !set.Has(value) {
+		return fmt.Errorf("MoveToEnd() value %v not found", value)
+	}
+	return set.dict.MoveToEnd(value, last)
+}
+
+// Removes and returns the last element of the set, or the first element if last is false.
+// Returns an error if the set is empty.
+func (set *OrderedSet[T]) Pop(last bool) (T, error) {
+	item, err := set.dict.PopItem(last)
+	if err != nil {
+		return item.Key, errors.New("cannot pop item from an empty set")
+	}
+	return item.Key, nil
+}
+
+// Removes the specified element from the set. Returns true if the element was in the set
+func (set *OrderedSet[T]) Remove(value T) bool {
+	return set.dict.Delete(value)
+}
+
+func (set *OrderedSet[T]) String() string {
+	return fmt.Sprintf("%v", set.Members())
+}
+
+// Returns a set that contains all items from both sets, except items that are present in both sets.
+// Elements of this set come first, followed by those of the given set.
+func (set1 *OrderedSet[T]) SymmetricDifference(set2 *OrderedSet[T]) *OrderedSet[T] {
+	return set1.Difference(set2).Union(set2.Difference(set1))
+}
+
+// Returns an unordered Set containing the elements of the set
+func (set *OrderedSet[T]) ToSet() Set[T] {
+	return NewSet(set.Members()...)
+}
+
+// Return a set that contains all items from both sets, with the elements of this set first
+func (set1 *OrderedSet[T]) Union(set2 *OrderedSet[T]) *OrderedSet[T] {
+	union := set1.Copy()
+	union.Add(set2.Members()...)
+	return union
+}
+
+// Adds all the items from the given sets
+func (set *OrderedSet[T]) Update(sets ...*OrderedSet[T]) {
+	for _, s := range sets {
+		set.Add(s.Members()...)
+	}
+}
@@ -0,0 +1,52 @@
+package godino
+
+import "fmt"
+
+func ExampleOrderedSet() {
+	set := NewOrderedSet("b", "a", "c", "a")
+	set.Add("d", "b")
+	fmt.Println(set)
+	// Output: [b a c d]
+}
+
+func ExampleOrderedSet_Equals() {
+	set1 := NewOrderedSet(1, 2, 3)
+	set2 := NewOrderedSet(3, 2, 1)
+	fmt.Println(set1.Equals(set2))
+	fmt.Println(set1.ToSet().Equals(set2.ToSet()))
+	// Output:
+	// false
+	// true
+}
+
+func ExampleOrderedSet_MoveToEnd() {
+	set := NewOrderedSet(1, 2, 3)
+	set.MoveToEnd(1, true)
+	fmt.Println(set)
+	set.MoveToEnd(3, false)
+	fmt.Println(set)
+	// Output:
+	// [2 3 1]
+	// [3 2 1]
+}
+
+func ExampleOrderedSet_Pop() {
+	set := NewOrderedSet(1, 2, 3)
+	fmt.Println(set.Pop(true))
+	fmt.Println(set.Pop(false))
+	// Output:
+	// 3 <nil>
+	// 1 <nil>
+}
+
+func ExampleOrderedSet_Union() {
+	set1 := NewOrderedSet(3, 1)
+	set2 := NewOrderedSet(2, 1, 4)
+	fmt.Println(set1.Union(set2))
+	fmt.Println(set1.Intersection(set2))
+	fmt.Println(set1.Difference(set2))
+	// Output:
+	// [3 1 2 4]
+	// [1]
+	// [3]
+}
@@ -0,0 +1,135 @@
+package godino
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderedSet(t *testing.T) {
+	t.Run("should preserve insertion order", func(t *testing.T) {
+		set := NewOrderedSet(3, 1, 2, 1, 3)
+		set.Add(5, 1)
+		assert.Equal(t, []int{3, 1, 2, 5}, set.Members())
+		assert.Equal(t, 4, set.Len())
+		assert.True(t, set.Has(5))
+		assert.False(t, set.Has(4))
+	})
+
+	t.Run("should be usable as a zero value", func(t *testing.T) {
+		var set OrderedSet[int]
+		assert.Equal(t, 0, set.Len())
+		set.Add(2, 1)
+		assert.Equal(t, []int{2, 1}, set.Members())
+	})
+
+	t.Run("should remove elements", func(t *testing.T) {
+		set := NewOrderedSet(1, 2, 3)
+		assert.True(t, set.Remove(2))
+		assert.False(t, set.Remove(2))
+		set.Discard(1)
+		set.Discard(10)
+		set.Add(2)
+		assert.Equal(t, []int{3, 2}, set.Members())
+		set.Clear()
+		assert.Empty(t, set.Members())
+	})
+
+	t.Run("should return an independent copy", func(t *testing.T) {
+		set := NewOrderedSet(1, 2, 3)
+		c := set.Copy()
+		c.Add(4)
+		assert.Equal(t, []int{1, 2, 3}, set.Members())
+		assert.Equal(t, []int{1, 2, 3, 4}, c.Members())
+	})
+
+	t.Run("should move elements to either end", func(t *testing.T) {
+		set := NewOrderedSet(1, 2, 3)
+		assert.NoError(t, set.MoveToEnd(1, true))
+		assert.NoError(t, set.MoveToEnd(3, false))
+		assert.Equal(t, []int{3, 2, 1}, set.Members())
+		assert.EqualError(t, set.MoveToEnd(4, true), "MoveToEnd() value 4 not found")
+	})
+
+	t.Run("should pop elements from either end", func(t *testing.T) {
+		set := NewOrderedSet(1, 2, 3)
+		v, err := set.Pop(true)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, v)
+		v, err = set.Pop(false)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, v)
+		v, err = set.Pop(false)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, v)
+		_, err = set.Pop(true)
+		assert.Error(t, err)
+	})
+
+	t.Run("should compare sets taking order into account", func(t *testing.T) {
+		assert.True(t, NewOrderedSet(1, 2, 3).Equals(NewOrderedSet(1, 2, 3)))
+		assert.False(t, NewOrderedSet(1, 2, 3).Equals(NewOrderedSet(3, 2, 1)))
+		assert.False(t, NewOrderedSet(1, 2, 3).Equals(NewOrderedSet(1, 2)))
+		assert.True(t, NewOrderedSet(1, 2, 3).ToSet().Equals(NewOrderedSet(3, 2, 1).ToSet()))
+	})
+
+	t.Run("should perform set operations preserving order", func(t *testing.T) {
+		set1 := NewOrderedSet(5, 1, 4, 2)
+		set2 := NewOrderedSet(2, 3, 5)
+		set3 := NewOrderedSet(4, 5)
+		assert.Equal(t, []int{1, 4}, set1.Difference(set2).Members())
+		assert.Equal(t, []int{1}, set1.Difference(set2, set3).Members())
+		assert.Equal(t, []int{5, 2}, set1.Intersection(set2).Members())
+		assert.Equal(t, []int{5}, set1.Intersection(set2, set3).Members())
+		assert.Equal(t, []int{5, 1, 4, 2, 3}, set1.Union(set2).Members())
+		assert.Equal(t, []int{1, 4, 3}, set1.SymmetricDifference(set2).Members())
+		assert.Equal(t, []int{5, 1, 4, 2}, set1.Members())
+
+		set1.Update(set2, set3)
+		assert.Equal(t, []int{5, 1, 4, 2, 3}, set1.Members())
+		set1.DifferenceUpdate(set3)
+		assert.Equal(t, []int{1, 2, 3}, set1.Members())
+		set1.IntersectionUpdate(set2)
+		assert.Equal(t, []int{2, 3}, set1.Members())
+	})
+
+	t.Run("should compare set membership", func(t *testing.T) {
+		set1 := NewOrderedSet(1, 2)
+		set2 := NewOrderedSet(2, 1, 3)
+		assert.True(t, set1.IsSubset(set2))
+		assert.False(t, set2.IsSubset(set1))
+		assert.True(t, set2.IsSuperset(set1))
+		assert.False(t, set1.IsDisjoint(set2))
+		assert.True(t, set1.IsDisjoint(NewOrderedSet(4)))
+	})
+
+	t.Run("should share state between copies of the value", func(t *testing.T) {
+		var set OrderedSet[int]
+		set.Add(1)
+		set2 := set
+		set2.Add(2)
+		assert.Equal(t, []int{1, 2}, set.Members())
+		assert.Equal(t, []int{1, 2}, set2.Members())
+	})
+
+	t.Run("should not modify a zero value when reading", func(t *testing.T) {
+		var set OrderedSet[int]
+		var wg sync.WaitGroup
+		for i := 0; i < 4; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				assert.False(t, set.Has(1))
+				assert.Empty(t, set.Members())
+				assert.Equal(t, 0, set.Len())
+			}()
+		}
+		wg.Wait()
+		assert.Nil(t, set.dict.root)
+	})
+
+	t.Run("should format the set as a string", func(t *testing.T) {
+		assert.Equal(t, "[3 1 2]", NewOrderedSet(3, 1, 2).String())
+	})
+}
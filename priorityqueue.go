@@ -0,0 +1,187 @@
+package godino
+
+import (
+	"container/heap"
+	"errors"
+	"fmt"
+
+	"golang.org/x/exp/constraints"
+)
+
+// A handle to a value in a priority queue, returned by Push, which can be used to update or remove the value
+type PriorityQueueItem[T any] struct {
+	Value T
+	// index is the item's position in the heap, or -1 once it has been removed
+	index int
+}
+
+type priorityQueueItems[T any] struct {
+	items []*PriorityQueueItem[T]
+	less  func(a, b T) bool
+}
+
+func (h priorityQueueItems[T]) Len() int { return len(h.items) }
+
+func (h priorityQueueItems[T]) Less(i, j int) bool { return h.less(h.items[i].Value, h.items[j].Value) }
+
+func (h priorityQueueItems[T]) Swap(i, j int) {
+	h.items[i], h.items[j] = h.items[j], h.items[i]
+	h.items[i].index = i
+	h.items[j].index = j
+}
+
+func (h *priorityQueueItems[T]) Push(x any) {
+	item := x.(*PriorityQueueItem[T])
+	item.index = len(h.items)
+	h.items = append(h.items, item)
+}
+
+func (h *priorityQueueItems[T]) Pop() any {
+	last := len(h.items) - 1
+	item := h.items[last]
+	h.items[last] = nil
+	h.items = h.items[:last]
+	item.index = -1
+	return item
+}
+
+// A queue which always pops its smallest value first, backed by a binary heap.
+// Pushes and pops are O(log n) and peeking is O(1).
+type PriorityQueue[T any] struct {
+	heap priorityQueueItems[T]
+}
+
+// Returns a new priority queue containing the values provided, which pops the smallest value first.
+// Use NewPriorityQueueFunc with a reversed comparison to pop the largest value first.
+func NewPriorityQueue[T constraints.Ordered](values ...T) *PriorityQueue[T] {
+	return NewPriorityQueueFunc(func(a, b T) bool { return a < b }, values...)
+}
+
+// Returns a new priority queue containing the values provided, where less reports whether a should be
+// popped before b
+func NewPriorityQueueFunc[T any](less func(a, b T) bool, values ...T) *PriorityQueue[T] {
+	q := &PriorityQueue[T]{heap: priorityQueueItems[T]{
+		items: make([]*PriorityQueueItem[T], len(values)),
+		less:  less,
+	}}
+	for i, v := range values {
+		q.heap.items[i] = &PriorityQueueItem[T]{Value: v, index: i}
+	}
+	heap.Init(&q.heap)
+	return q
+}
+
+func (q *PriorityQueue[T]) contains(item *PriorityQueueItem[T]) bool {
+	return item.index >= 0 && item.index < len(q.heap.items) && q.heap.items[item.index] == item
+}
+
+// Removes all values from the queue
+func (q *PriorityQueue[T]) Clear() {
+	for _, item := range q.heap.items {
+		item.index = -1
+	}
+	q.heap.items = nil
+}
+
+// Restores the order of the queue after the value of the item has been changed.
+// Returns an error if the item is not in the queue.
+func (q *PriorityQueue[T]) Fix(item *PriorityQueueItem[T]) error {
+	if !q.contains(item) {
+		return errors.New("Fix() item is not in the priority queue")
+	}
+	heap.Fix(&q.heap, item.index)
+	return nil
+}
+
+// Returns the number of values in the queue
+func (q *PriorityQueue[T]) Len() int {
+	return len(q.heap.items)
+}
+
+// Returns the values in the queue in heap order, where the first value is the smallest
+func (q *PriorityQueue[T]) Members() []T {
+	values := make([]T, len(q.heap.items))
+	for i, item := range q.heap.items {
+		values[i] = item.Value
+	}
+	return values
+}
+
+// Returns the smallest value without removing it. Returns an error if the queue is empty.
+func (q *PriorityQueue[T]) Peek() (T, error) {
+	var value T
+	if len(q.heap.items) == 0 {
+		return value, errors.New("Peek() called on an empty priority queue")
+	}
+	return q.heap.items[0].Value, nil
+}
+
+// Removes and returns the smallest value. Returns an error if the queue is empty.
+func (q *PriorityQueue[T]) Pop() (T, error) {
+	var value T
+	if len(q.heap.items) == 0 {
+		return value, errors.New("Pop() called on an empty priority queue")
+	}
+	return heap.Pop(&q.heap).(*PriorityQueueItem[T]).Value, nil
+}
+
+// Adds a value to the queue. Returns a handle which can be used to update or remove the value.
+func (q *PriorityQueue[T]) Push(value T) *PriorityQueueItem[T] {
+	item := &PriorityQueueItem[T]{Value: value}
+	heap.Push(&q.heap, item)
+	return item
+}
+
+// Adds a value to the queue and then removes and returns the smallest value.
+// This is more efficient than calling Push followed by Pop. Also returns a handle for the added value,
+// or nil if the added value was the smallest and so was returned straight away.
+func (q *PriorityQueue[T]) PushPop(value T) (T, *PriorityQueueItem[T]) {
+	if len(q.heap.items) == 0 || !q.heap.less(q.heap.items[0].Value, value) {
+		return value, nil
+	}
+	smallest := q.heap.items[0]
+	smallest.index = -1
+	item := &PriorityQueueItem[T]{Value: value}
+	q.heap.items[0] = item
+	heap.Fix(&q.heap, 0)
+	return smallest.Value, item
+}
+
+// Removes the item from the queue and returns its value. Returns an error if the item is not in the queue.
+func (q *PriorityQueue[T]) Remove(item *PriorityQueueItem[T]) (T, error) {
+	var value T
+	if !q.contains(item) {
+		return value, errors.New("Remove() item is not in the priority queue")
+	}
+	return heap.Remove(&q.heap, item.index).(*PriorityQueueItem[T]).Value, nil
+}
+
+// Removes and returns the smallest value and then adds the given value, returning a handle for it.
+// This is more efficient than calling Pop followed by Push. Returns an error if the queue is empty.
+func (q *PriorityQueue[T]) Replace(value T) (T, *PriorityQueueItem[T], error) {
+	var smallest T
+	if len(q.heap.items) == 0 {
+		return smallest, nil, errors.New("Replace() called on an empty priority queue")
+	}
+	removed := q.heap.items[0]
+	removed.index = -1
+	item := &PriorityQueueItem[T]{Value: value}
+	q.heap.items[0] = item
+	heap.Fix(&q.heap, 0)
+	return removed.Value, item, nil
+}
+
+func (q *PriorityQueue[T]) String() string {
+	return fmt.Sprintf("%v", q.Members())
+}
+
+// Changes the value of the item and restores the order of the queue.
+// Returns an error if the item is not in the queue.
+func (q *PriorityQueue[T]) Update(item *PriorityQueueItem[T], value T) error {
+	if !q.contains(item) {
+		return errors.New("Update() item is not in the priority queue")
+	}
+	item.Value = value
+	heap.Fix(&q.heap, item.index)
+	return nil
+}
@@ -0,0 +1,43 @@
+package godino
+
+import "fmt"
+
+func ExamplePriorityQueue() {
+	q := NewPriorityQueue(5, 1, 3)
+	q.Push(2)
+	for q.Len() > 0 {
+		v, _ := q.Pop()
+		fmt.Print(v, " ")
+	}
+	fmt.Println()
+	// Output: 1 2 3 5
+}
+
+func ExamplePriorityQueue_Update() {
+	type task struct {
+		name     string
+		priority int
+	}
+	q := NewPriorityQueueFunc(func(a, b task) bool { return a.priority < b.priority })
+	q.Push(task{"write", 2})
+	q.Push(task{"test", 3})
+	deploy := q.Push(task{"deploy", 4})
+	q.Update(deploy, task{"deploy", 1})
+	next, _ := q.Pop()
+	fmt.Println(next.name)
+	// Output: deploy
+}
+
+func ExampleHeapPush() {
+	h := []int{5, 2, 8}
+	Heapify(h)
+	HeapPush(&h, 1)
+	fmt.Println(HeapPop(&h), HeapPop(&h), HeapPop(&h))
+	// Output: 1 2 5
+}
+
+func ExampleMerge() {
+	merged := Merge(Iter([]int{1, 4, 9}), Iter([]int{2, 3, 10}))
+	fmt.Println(merged.ToArray())
+	// Output: [1 2 3 4 9 10]
+}
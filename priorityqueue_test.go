@@ -0,0 +1,150 @@
+package godino
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPriorityQueue(t *testing.T) {
+	t.Run("should pop values from smallest to largest", func(t *testing.T) {
+		q := NewPriorityQueue(5, 1, 4)
+		q.Push(3)
+		q.Push(2)
+		assert.Equal(t, 5, q.Len())
+		popped := []int{}
+		for q.Len() > 0 {
+			v, err := q.Pop()
+			assert.NoError(t, err)
+			popped = append(popped, v)
+		}
+		assert.Equal(t, []int{1, 2, 3, 4, 5}, popped)
+	})
+
+	t.Run("should order values with a custom comparison", func(t *testing.T) {
+		q := NewPriorityQueueFunc(func(a, b string) bool { return len(a) > len(b) }, "a", "ccc", "bb")
+		v, err := q.Peek()
+		assert.NoError(t, err)
+		assert.Equal(t, "ccc", v)
+		assert.Equal(t, 3, q.Len())
+	})
+
+	t.Run("should return errors when empty", func(t *testing.T) {
+		q := NewPriorityQueue[int]()
+		_, err := q.Peek()
+		assert.EqualError(t, err, "Peek() called on an empty priority queue")
+		_, err = q.Pop()
+		assert.EqualError(t, err, "Pop() called on an empty priority queue")
+		_, item, err := q.Replace(1)
+		assert.Nil(t, item)
+		assert.EqualError(t, err, "Replace() called on an empty priority queue")
+	})
+
+	t.Run("should push and pop in a single operation", func(t *testing.T) {
+		q := NewPriorityQueue(3, 5)
+		v, item := q.PushPop(1)
+		assert.Equal(t, 1, v)
+		assert.Nil(t, item, "the pushed value was popped straight away")
+		v, item = q.PushPop(3)
+		assert.Equal(t, 3, v)
+		assert.Nil(t, item)
+		v, item = q.PushPop(4)
+		assert.Equal(t, 3, v)
+		assert.Equal(t, []int{4, 5}, q.Members())
+		assert.NoError(t, q.Update(item, 10))
+		assert.Equal(t, []int{5, 10}, q.Members())
+		v, _ = NewPriorityQueue[int]().PushPop(7)
+		assert.Equal(t, 7, v)
+	})
+
+	t.Run("should pop and push in a single operation", func(t *testing.T) {
+		q := NewPriorityQueue(3, 5)
+		v, one, err := q.Replace(1)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, v)
+		v, six, err := q.Replace(6)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, v)
+		assert.Equal(t, 1, one.Value)
+		assert.Error(t, q.Fix(one), "the replaced value's handle is no longer valid")
+		assert.ElementsMatch(t, []int{5, 6}, q.Members())
+		v, err = q.Remove(six)
+		assert.NoError(t, err)
+		assert.Equal(t, 6, v)
+		assert.Equal(t, []int{5}, q.Members())
+	})
+
+	t.Run("should update, fix and remove values by handle", func(t *testing.T) {
+		q := NewPriorityQueue[int]()
+		a := q.Push(10)
+		b := q.Push(20)
+		c := q.Push(30)
+		assert.NoError(t, q.Update(c, 5))
+		v, _ := q.Peek()
+		assert.Equal(t, 5, v)
+		a.Value = 40
+		assert.NoError(t, q.Fix(a))
+		v, err := q.Remove(b)
+		assert.NoError(t, err)
+		assert.Equal(t, 20, v)
+		assert.Equal(t, "[5 40]", q.String())
+
+		_, err = q.Remove(b)
+		assert.EqualError(t, err, "Remove() item is not in the priority queue")
+		assert.EqualError(t, q.Update(b, 1), "Update() item is not in the priority queue")
+		assert.EqualError(t, q.Fix(b), "Fix() item is not in the priority queue")
+
+		other := NewPriorityQueue(1, 2, 3)
+		assert.Error(t, other.Fix(a), "should not accept handles from another queue")
+
+		q.Pop()
+		assert.Error(t, q.Update(c, 1), "should not accept handles of popped values")
+		q.Clear()
+		assert.Equal(t, 0, q.Len())
+		assert.Error(t, q.Fix(a), "should not accept handles of cleared values")
+	})
+
+	t.Run("should stay ordered through random operations", func(t *testing.T) {
+		r := rand.New(rand.NewSource(1))
+		q := NewPriorityQueue[int]()
+		handles := []*PriorityQueueItem[int]{}
+		expected := []int{}
+		for i := 0; i < 500; i++ {
+			switch r.Intn(4) {
+			case 0:
+				if len(handles) > 0 {
+					j := r.Intn(len(handles))
+					v, err := q.Remove(handles[j])
+					assert.NoError(t, err)
+					expected = remove(expected, v)
+					handles = append(handles[:j], handles[j+1:]...)
+				}
+			case 1:
+				if len(handles) > 0 {
+					j := r.Intn(len(handles))
+					old := handles[j].Value
+					assert.NoError(t, q.Update(handles[j], r.Intn(100)))
+					expected = append(remove(expected, old), handles[j].Value)
+				}
+			default:
+				v := r.Intn(100)
+				handles = append(handles, q.Push(v))
+				expected = append(expected, v)
+			}
+		}
+		sort.Ints(expected)
+		popped := []int{}
+		for q.Len() > 0 {
+			v, _ := q.Pop()
+			popped = append(popped, v)
+		}
+		assert.Equal(t, expected, popped)
+	})
+}
+
+func remove(arr []int, value int) []int {
+	i := Index(arr, value)
+	return append(arr[:i], arr[i+1:]...)
+}
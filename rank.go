@@ -0,0 +1,306 @@
+package godino
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+)
+
+// Returns nCr, or the maximum int if the result would overflow. Any index is smaller than a saturated count.
+func saturatingComb(n, k int) int {
+	c, err := Comb(n, k)
+	if errors.Is(err, ErrOverflow) {
+		return math.MaxInt
+	}
+	return c
+}
+
+// Returns nPr, or the maximum int if the result would overflow. Any index is smaller than a saturated count.
+func saturatingPerm(n, k int) int {
+	p, err := Perm(n, k)
+	if errors.Is(err, ErrOverflow) {
+		return math.MaxInt
+	}
+	return p
+}
+
+func nthCombinationIndices(call string, n, length, index int) ([]int, error) {
+	if length < 0 || length > n {
+		return nil, fmt.Errorf("%s length %d out of range for %d elements", call, length, n)
+	}
+	if index < 0 || index >= saturatingComb(n, length) {
+		return nil, fmt.Errorf("%s index %d out of range", call, index)
+	}
+	indices := make([]int, length)
+	c := 0
+	for j := range indices {
+		// Skip past the blocks of combinations that begin with an earlier element
+		for {
+			block := saturatingComb(n-c-1, length-j-1)
+			if index < block {
+				break
+			}
+			index -= block
+			c++
+		}
+		indices[j] = c
+		c++
+	}
+	return indices, nil
+}
+
+func nthPermutationIndices(call string, n, length, index int) ([]int, error) {
+	if length == -1 {
+		length = n
+	}
+	if length < 0 || length > n {
+		return nil, fmt.Errorf("%s length %d out of range for %d elements", call, length, n)
+	}
+	if index < 0 || index >= saturatingPerm(n, length) {
+		return nil, fmt.Errorf("%s index %d out of range", call, index)
+	}
+	remaining := make([]int, n)
+	for i := range remaining {
+		remaining[i] = i
+	}
+	indices := make([]int, length)
+	for i := range indices {
+		block := saturatingPerm(n-i-1, length-i-1)
+		d := index / block
+		index %= block
+		indices[i] = remaining[d]
+		remaining = append(remaining[:d], remaining[d+1:]...)
+	}
+	return indices, nil
+}
+
+// Advances the indices of a permutation of n elements to the next permutation in lexicographic order.
+// Returns false if the indices were the last permutation.
+func nextPermutation(indices []int, used []bool) bool {
+	n := len(used)
+	for i := len(indices) - 1; i >= 0; i-- {
+		used[indices[i]] = false
+		for v := indices[i] + 1; v < n; v++ {
+			if used[v] {
+				continue
+			}
+			indices[i] = v
+			used[v] = true
+			// Fill the remaining positions with the smallest unused indices
+			next := 0
+			for j := i + 1; j < len(indices); j++ {
+				for used[next] {
+					next++
+				}
+				indices[j] = next
+				used[next] = true
+			}
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the combination at the given index of the sequence produced by Combinations.
+// Returns an error if the length or index is out of range.
+func NthCombination[T any](arr []T, length, index int) ([]T, error) {
+	indices, err := nthCombinationIndices("NthCombination()", len(arr), length, index)
+	if err != nil {
+		return nil, err
+	}
+	return selectIndices(arr, indices), nil
+}
+
+// Returns the index of the combination in the sequence produced by Combinations.
+// Each element is matched to the first unused equal element of the array that keeps the combination in order.
+// Returns an error if the combination is not a combination of the array or the index overflows an int.
+func CombinationIndex[T comparable](arr []T, combination []T) (int, error) {
+	n, k := len(arr), len(combination)
+	index := big.NewInt(0)
+	c := 0
+	for j, value := range combination {
+		for c < n && arr[c] != value {
+			block, _ := BigComb(n-c-1, k-j-1)
+			index.Add(index, block)
+			c++
+		}
+		if c == n {
+			return 0, fmt.Errorf("CombinationIndex() %v is not a combination of %v", combination, arr)
+		}
+		c++
+	}
+	return bigToInt(index, "CombinationIndex()")
+}
+
+// Returns the permutation at the given index of the sequence produced by Permutations.
+// If -1 is provided for the length, the length of the array is used.
+// Returns an error if the length or index is out of range.
+func NthPermutation[T any](arr []T, length, index int) ([]T, error) {
+	indices, err := nthPermutationIndices("NthPermutation()", len(arr), length, index)
+	if err != nil {
+		return nil, err
+	}
+	return selectIndices(arr, indices), nil
+}
+
+// Returns the index of the permutation in the sequence produced by Permutations.
+// Each element is matched to the first unused equal element of the array.
+// Returns an error if the permutation is not a permutation of the array or the index overflows an int.
+func PermutationIndex[T comparable](arr []T, permutation []T) (int, error) {
+	n, k := len(arr), len(permutation)
+	if k > n {
+		return 0, fmt.Errorf("PermutationIndex() %v is not a permutation of %v", permutation, arr)
+	}
+	used := make([]bool, n)
+	index := big.NewInt(0)
+	for i, value := range permutation {
+		// d is the position of the element among the unused elements
+		d, found := 0, false
+		for j, v := range arr {
+			if used[j] {
+				continue
+			}
+			if v == value {
+				used[j] = true
+				found = true
+				break
+			}
+			d++
+		}
+		if !found {
+			return 0, fmt.Errorf("PermutationIndex() %v is not a permutation of %v", permutation, arr)
+		}
+		block, _ := BigPerm(n-i-1, k-i-1)
+		index.Add(index, block.Mul(block, big.NewInt(int64(d))))
+	}
+	return bigToInt(index, "PermutationIndex()")
+}
+
+// Returns an iterator over the combinations with indices from start up to but not including stop,
+// in the order produced by Combinations. A stop of -1 continues until the last combination.
+// Returns an error if the length or indices are out of range.
+func CombinationsRange[T any](arr []T, length, start, stop int) (*Iterator[[]T], error) {
+	pool := Copy(arr)
+	if stop != -1 && stop < start {
+		return nil, fmt.Errorf("CombinationsRange() stop %d is before start %d", stop, start)
+	}
+	if start == stop {
+		return emptyIterator[[]T](), nil
+	}
+	indices, err := nthCombinationIndices("CombinationsRange()", len(pool), length, start)
+	if err != nil {
+		return nil, err
+	}
+	remaining := stop - start
+	started := false
+	return NewIterator(func() ([]T, bool) {
+		if remaining == 0 || (started && !nextCombination(indices, len(pool))) {
+			return nil, false
+		}
+		started = true
+		remaining--
+		return selectIndices(pool, indices), true
+	}), nil
+}
+
+// Returns an iterator over the permutations with indices from start up to but not including stop,
+// in the order produced by Permutations. If -1 is provided for the length, the length of the array is used.
+// A stop of -1 continues until the last permutation. Returns an error if the length or indices are out of range.
+func PermutationsRange[T any](arr []T, length, start, stop int) (*Iterator[[]T], error) {
+	pool := Copy(arr)
+	if stop != -1 && stop < start {
+		return nil, fmt.Errorf("PermutationsRange() stop %d is before start %d", stop, start)
+	}
+	if start == stop {
+		return emptyIterator[[]T](), nil
+	}
+	indices, err := nthPermutationIndices("PermutationsRange()", len(pool), length, start)
+	if err != nil {
+		return nil, err
+	}
+	used := make([]bool, len(pool))
+	for _, i := range indices {
+		used[i] = true
+	}
+	remaining := stop - start
+	started := false
+	return NewIterator(func() ([]T, bool) {
+		if remaining == 0 || (started && !nextPermutation(indices, used)) {
+			return nil, false
+		}
+		started = true
+		remaining--
+		return selectIndices(pool, indices), true
+	}), nil
+}
+
+// Returns the boundaries of n contiguous ranges covering total items, with sizes differing by at most one
+func splitRange(total, n int) [][2]int {
+	ranges := make([][2]int, 0, n)
+	size, extra := total/n, total%n
+	start := 0
+	for i := 0; i < n; i++ {
+		stop := start + size
+		if i < extra {
+			stop++
+		}
+		ranges = append(ranges, [2]int{start, stop})
+		start = stop
+	}
+	return ranges
+}
+
+// Splits the combinations produced by Combinations into n independent iterators over contiguous
+// ranges of similar size, e.g. to share the work between n goroutines.
+// Returns an error if n is less than 1, the length is out of range or the number of combinations overflows an int.
+func SplitCombinations[T any](arr []T, length, n int) ([]*Iterator[[]T], error) {
+	if n < 1 {
+		return nil, errors.New("SplitCombinations() expected at least 1 part")
+	}
+	if length < 0 || length > len(arr) {
+		return nil, fmt.Errorf("SplitCombinations() length %d out of range for %d elements", length, len(arr))
+	}
+	total, err := Comb(len(arr), length)
+	if err != nil {
+		return nil, err
+	}
+	its := []*Iterator[[]T]{}
+	for _, r := range splitRange(total, n) {
+		it, err := CombinationsRange(arr, length, r[0], r[1])
+		if err != nil {
+			return nil, err
+		}
+		its = append(its, it)
+	}
+	return its, nil
+}
+
+// Splits the permutations produced by Permutations into n independent iterators over contiguous
+// ranges of similar size, e.g. to share the work between n goroutines. If -1 is provided for the
+// length, the length of the array is used. Returns an error if n is less than 1, the length is out
+// of range or the number of permutations overflows an int.
+func SplitPermutations[T any](arr []T, length, n int) ([]*Iterator[[]T], error) {
+	if n < 1 {
+		return nil, errors.New("SplitPermutations() expected at least 1 part")
+	}
+	if length == -1 {
+		length = len(arr)
+	}
+	if length < 0 || length > len(arr) {
+		return nil, fmt.Errorf("SplitPermutations() length %d out of range for %d elements", length, len(arr))
+	}
+	total, err := Perm(len(arr), length)
+	if err != nil {
+		return nil, err
+	}
+	its := []*Iterator[[]T]{}
+	for _, r := range splitRange(total, n) {
+		it, err := PermutationsRange(arr, length, r[0], r[1])
+		if err != nil {
+			return nil, err
+		}
+		its = append(its, it)
+	}
+	return its, nil
+}
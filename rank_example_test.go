@@ -0,0 +1,57 @@
+package godino
+
+import "fmt"
+
+func ExampleNthCombination() {
+	fmt.Println(NthCombination([]string{"a", "b", "c", "d"}, 2, 4))
+	// Output: [b d] <nil>
+}
+
+func ExampleCombinationIndex() {
+	fmt.Println(CombinationIndex([]string{"a", "b", "c", "d"}, []string{"b", "d"}))
+	// Output: 4 <nil>
+}
+
+func ExampleNthPermutation() {
+	fmt.Println(NthPermutation([]int{1, 2, 3}, -1, 3))
+	// Output: [2 3 1] <nil>
+}
+
+func ExamplePermutationIndex() {
+	fmt.Println(PermutationIndex([]int{1, 2, 3}, []int{2, 3, 1}))
+	// Output: 3 <nil>
+}
+
+func ExampleCombinationsRange() {
+	it, _ := CombinationsRange([]int{1, 2, 3, 4}, 2, 2, 5)
+	fmt.Println(GeneratorToArray(it))
+	// Output: [[1 4] [2 3] [2 4]]
+}
+
+func ExamplePermutationsRange() {
+	it, _ := PermutationsRange([]int{1, 2, 3}, -1, 4, -1)
+	fmt.Println(GeneratorToArray(it))
+	// Output: [[3 1 2] [3 2 1]]
+}
+
+func ExampleSplitPermutations() {
+	its, _ := SplitPermutations([]int{1, 2, 3}, -1, 2)
+	for _, it := range its {
+		fmt.Println(GeneratorToArray(it))
+	}
+	// Output:
+	// [[1 2 3] [1 3 2] [2 1 3]]
+	// [[2 3 1] [3 1 2] [3 2 1]]
+}
+
+func ExampleSplitCombinations() {
+	its, _ := SplitCombinations([]int{1, 2, 3, 4}, 2, 4)
+	for _, it := range its {
+		fmt.Println(GeneratorToArray(it))
+	}
+	// Output:
+	// [[1 2] [1 3]]
+	// [[1 4] [2 3]]
+	// [[2 4]]
+	// [[3 4]]
+}
@@ -0,0 +1,168 @@
+package godino
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNthCombination(t *testing.T) {
+	arr := []string{"a", "b", "c", "d", "e"}
+	for length := 0; length <= len(arr); length++ {
+		combos := GeneratorToArray(Combinations(arr, length))
+		for i, expected := range combos {
+			combo, err := NthCombination(arr, length, i)
+			assert.NoError(t, err)
+			assert.Equal(t, expected, combo)
+
+			index, err := CombinationIndex(arr, combo)
+			assert.NoError(t, err)
+			assert.Equal(t, i, index)
+		}
+		_, err := NthCombination(arr, length, len(combos))
+		assert.Error(t, err)
+	}
+
+	_, err := NthCombination(arr, 6, 0)
+	assert.Error(t, err)
+	_, err = NthCombination(arr, 2, -1)
+	assert.Error(t, err)
+	_, err = CombinationIndex(arr, []string{"b", "a"})
+	assert.Error(t, err)
+}
+
+func TestNthCombinationLargeSpace(t *testing.T) {
+	arr := make([]int, 100)
+	for i := range arr {
+		arr[i] = i
+	}
+	// The total number of combinations overflows an int but small indices are still reachable
+	combo, err := NthCombination(arr, 50, 1)
+	assert.NoError(t, err)
+	expected := append(Copy(arr[:49]), 50)
+	assert.Equal(t, expected, combo)
+
+	index, err := CombinationIndex(arr, combo)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, index)
+
+	_, err = CombinationIndex(arr, arr[50:])
+	assert.ErrorIs(t, err, ErrOverflow)
+}
+
+func TestNthPermutation(t *testing.T) {
+	arr := []string{"a", "b", "c", "d"}
+	for length := 0; length <= len(arr); length++ {
+		perms := GeneratorToArray(Permutations(arr, length))
+		for i, expected := range perms {
+			perm, err := NthPermutation(arr, length, i)
+			assert.NoError(t, err)
+			assert.Equal(t, expected, perm)
+
+			index, err := PermutationIndex(arr, perm)
+			assert.NoError(t, err)
+			assert.Equal(t, i, index)
+		}
+		_, err := NthPermutation(arr, length, len(perms))
+		assert.Error(t, err)
+	}
+
+	perm, err := NthPermutation(arr, -1, 23)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"d", "c", "b", "a"}, perm)
+
+	_, err = PermutationIndex(arr, []string{"a", "a"})
+	assert.Error(t, err)
+	_, err = PermutationIndex(arr, []string{"a", "b", "c", "d", "e"})
+	assert.Error(t, err)
+}
+
+func TestPermutationIndexWithDuplicates(t *testing.T) {
+	arr := []int{1, 1, 2}
+	index, err := PermutationIndex(arr, []int{1, 2, 1})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, index)
+}
+
+func TestRanges(t *testing.T) {
+	arr := []int{1, 2, 3, 4, 5}
+
+	t.Run("combinations range", func(t *testing.T) {
+		all := GeneratorToArray(Combinations(arr, 3))
+		it, err := CombinationsRange(arr, 3, 2, 6)
+		assert.NoError(t, err)
+		assert.Equal(t, all[2:6], it.ToArray())
+
+		it, err = CombinationsRange(arr, 3, 7, -1)
+		assert.NoError(t, err)
+		assert.Equal(t, all[7:], it.ToArray())
+
+		_, err = CombinationsRange(arr, 3, 4, 2)
+		assert.Error(t, err)
+	})
+
+	t.Run("permutations range", func(t *testing.T) {
+		all := GeneratorToArray(Permutations(arr, 3))
+		it, err := PermutationsRange(arr, 3, 5, 40)
+		assert.NoError(t, err)
+		assert.Equal(t, all[5:40], it.ToArray())
+
+		it, err = PermutationsRange(arr, -1, 100, -1)
+		assert.NoError(t, err)
+		assert.Equal(t, GeneratorToArray(Permutations(arr, -1))[100:], it.ToArray())
+
+		_, err = PermutationsRange(arr, 3, 60, -1)
+		assert.Error(t, err)
+	})
+}
+
+func TestSplit(t *testing.T) {
+	arr := []int{1, 2, 3, 4, 5}
+
+	collect := func(its []*Iterator[[]int]) [][]int {
+		results := make([][][]int, len(its))
+		var wg sync.WaitGroup
+		for i, it := range its {
+			wg.Add(1)
+			go func(i int, it *Iterator[[]int]) {
+				defer wg.Done()
+				results[i] = it.ToArray()
+			}(i, it)
+		}
+		wg.Wait()
+		all := [][]int{}
+		for _, r := range results {
+			all = append(all, r...)
+		}
+		return all
+	}
+
+	t.Run("split permutations", func(t *testing.T) {
+		its, err := SplitPermutations(arr, -1, 7)
+		assert.NoError(t, err)
+		assert.Equal(t, 7, len(its))
+		assert.Equal(t, GeneratorToArray(Permutations(arr, -1)), collect(its))
+	})
+
+	t.Run("split combinations", func(t *testing.T) {
+		its, err := SplitCombinations(arr, 2, 3)
+		assert.NoError(t, err)
+		assert.Equal(t, GeneratorToArray(Combinations(arr, 2)), collect(its))
+	})
+
+	t.Run("more parts than items", func(t *testing.T) {
+		its, err := SplitCombinations(arr, 5, 3)
+		assert.NoError(t, err)
+		assert.Equal(t, [][]int{{1, 2, 3, 4, 5}}, collect(its))
+	})
+
+	t.Run("invalid arguments", func(t *testing.T) {
+		_, err := SplitPermutations(arr, -1, 0)
+		assert.Error(t, err)
+		_, err = SplitCombinations(arr, 6, 2)
+		assert.Error(t, err)
+		_, err = SplitPermutations(make([]int, 30), -1, 2)
+		assert.ErrorIs(t, err, ErrOverflow)
+	})
+}
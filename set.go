@@ -72,6 +72,12 @@ func (set Set[T]) Equals(set2 Set[T]) bool {
 	return true
 }
 
+// Returns a frozen set containing the members of the set, including each NaN as a separate member.
+// See NewFrozenSet for the memory retained when the members contain pointers.
+func (set Set[T]) Freeze() FrozenSet[T] {
+	return NewFrozenSet(set.Members()...)
+}
+
 // Returns true if the set contins the specified element
 func (set Set[T]) Has(value T) bool {
 	_, ok := set[value]
@@ -0,0 +1,208 @@
+package godino
+
+import (
+	"fmt"
+
+	"golang.org/x/exp/constraints"
+)
+
+// A dictionary which keeps its keys in sorted order. Insertions, deletions and lookups by key or
+// index are O(log n). Two keys are considered equal when the comparison function returns zero.
+type SortedDict[K comparable, V any] struct {
+	tree sortedTree[DictItem[K, V]]
+}
+
+// Returns a new sorted dictionary containing the given items, with keys ordered from smallest to largest
+func NewSortedDict[K constraints.Ordered, V any](items ...DictItem[K, V]) *SortedDict[K, V] {
+	return NewSortedDictFunc(compareOrdered[K], items...)
+}
+
+// Returns a new sorted dictionary containing the given items, with keys ordered by the comparison function.
+// cmp should return a negative number when a < b, a positive number when a > b and zero when a == b.
+func NewSortedDictFunc[K comparable, V any](cmp func(a, b K) int, items ...DictItem[K, V]) *SortedDict[K, V] {
+	d := &SortedDict[K, V]{tree: sortedTree[DictItem[K, V]]{
+		cmp: func(a, b DictItem[K, V]) int { return cmp(a.Key, b.Key) },
+	}}
+	d.Update(items...)
+	return d
+}
+
+func (d *SortedDict[K, V]) find(key K) (int, bool) {
+	return d.tree.find(DictItem[K, V]{Key: key})
+}
+
+// Returns the index at which the key would be inserted, i.e. the number of keys less than the key
+func (d *SortedDict[K, V]) BisectLeft(key K) int {
+	return d.tree.bisect(DictItem[K, V]{Key: key}, false)
+}
+
+// Returns the index after the key would be inserted, i.e. the number of keys less than or equal to the key
+func (d *SortedDict[K, V]) BisectRight(key K) int {
+	return d.tree.bisect(DictItem[K, V]{Key: key}, true)
+}
+
+// Returns the item with the smallest key greater than or equal to the given key.
+// Returns a second boolean value which is false if there is no such item.
+func (d *SortedDict[K, V]) Ceiling(key K) (DictItem[K, V], bool) {
+	return d.tree.ceiling(DictItem[K, V]{Key: key})
+}
+
+// Removes all elements from the dictionary
+func (d *SortedDict[K, V]) Clear() {
+	d.tree.root = nil
+}
+
+// Returns a copy of the dictionary
+func (d *SortedDict[K, V]) Copy() *SortedDict[K, V] {
+	return &SortedDict[K, V]{tree: d.tree.copy()}
+}
+
+// Removes the given key from the dictionary. Returns true if the key was present.
+func (d *SortedDict[K, V]) Delete(key K) bool {
+	return d.tree.remove(DictItem[K, V]{Key: key})
+}
+
+// Returns the item with the largest key less than or equal to the given key.
+// Returns a second boolean value which is false if there is no such item.
+func (d *SortedDict[K, V]) Floor(key K) (DictItem[K, V], bool) {
+	return d.tree.floor(DictItem[K, V]{Key: key})
+}
+
+// Returns the value associated with given key.
+// In the case the the key is not present, a fallback value is returned if provided.
+// Otherwise the zero-value for the value type is returned.
+func (d *SortedDict[K, V]) Get(key K, fallback ...V) V {
+	i, ok := d.find(key)
+	if !ok {
+		var value V
+		if len(fallback) >= 1 {
+			value = fallback[0]
+		}
+		return value
+	}
+	return d.tree.at(i).Value
+}
+
+// Returns true if the dictionary contains the given key.
+func (d *SortedDict[K, V]) Has(key K) bool {
+	_, ok := d.find(key)
+	return ok
+}
+
+// Returns the index of the key in the dictionary, or -1 if the key is not present
+func (d *SortedDict[K, V]) Index(key K) int {
+	i, _ := d.find(key)
+	return i
+}
+
+// Returns an iterator over the items with keys between min and max inclusive, in sorted order.
+// The dictionary must not be modified while iterating.
+func (d *SortedDict[K, V]) IRange(min, max K) *Iterator[DictItem[K, V]] {
+	return d.tree.irange(DictItem[K, V]{Key: min}, DictItem[K, V]{Key: max})
+}
+
+// Returns an iterator over the items with indices from start up to but not including stop, in sorted order.
+// Indices are clamped to the bounds of the dictionary. The dictionary must not be modified while iterating.
+func (d *SortedDict[K, V]) ISlice(start, stop int) *Iterator[DictItem[K, V]] {
+	return d.tree.iter(start, stop)
+}
+
+// Returns an array of dictionary items (a struct with Key and Value fields) sorted by key
+func (d *SortedDict[K, V]) Items() []DictItem[K, V] {
+	return d.tree.values()
+}
+
+// Returns an array of keys present in the dictionary in sorted order
+func (d *SortedDict[K, V]) Keys() []K {
+	keys := make([]K, 0, d.Len())
+	for _, item := range d.Items() {
+		keys = append(keys, item.Key)
+	}
+	return keys
+}
+
+// Returns the number of items in the dictionary
+func (d *SortedDict[K, V]) Len() int {
+	return d.tree.len()
+}
+
+// Removes the given key from the dictionary and returns it's associated value.
+// If the key is not present in the dictionary, a fallback is returned if provided.
+// Otherwise a zero-value is returned.
+// Returns a second boolean value which is true if the key was present in the dictionary.
+func (d *SortedDict[K, V]) Pop(key K, fallback ...V) (V, bool) {
+	i, ok := d.find(key)
+	if !ok {
+		var value V
+		if len(fallback) >= 1 {
+			value = fallback[0]
+		}
+		return value, false
+	}
+	return d.tree.deleteAt(i).Value, true
+}
+
+// Removes and returns the item at the given index, or the item with the largest key if no index is given.
+// Negative indices count back from the end of the dictionary. Returns an error if the index is out of range.
+func (d *SortedDict[K, V]) PopItem(index ...int) (DictItem[K, V], error) {
+	return d.tree.pop(index...)
+}
+
+// Returns the number of keys less than the given key
+func (d *SortedDict[K, V]) Rank(key K) int {
+	return d.BisectLeft(key)
+}
+
+// Returns the item at the given index. Negative indices count back from the end of the dictionary.
+// Returns an error if the index is out of range.
+func (d *SortedDict[K, V]) Select(index int) (DictItem[K, V], error) {
+	return d.tree.selectIndex(index)
+}
+
+// Sets the value for the given key
+func (d *SortedDict[K, V]) Set(key K, value V) {
+	if i, ok := d.find(key); ok {
+		d.tree.node(i).value.Value = value
+		return
+	}
+	d.tree.insert(DictItem[K, V]{Key: key, Value: value})
+}
+
+// Sets the value for the given key if the key is not already present.
+// Returns the value for the given key.
+func (d *SortedDict[K, V]) SetDefault(key K, value V) V {
+	if i, ok := d.find(key); ok {
+		return d.tree.at(i).Value
+	}
+	d.tree.insert(DictItem[K, V]{Key: key, Value: value})
+	return value
+}
+
+func (d *SortedDict[K, V]) String() string {
+	return fmt.Sprintf("%v", d.Items())
+}
+
+// Returns an unordered Dict containing the items of the dictionary
+func (d *SortedDict[K, V]) ToDict() Dict[K, V] {
+	dict := make(Dict[K, V], d.Len())
+	for _, item := range d.Items() {
+		dict[item.Key] = item.Value
+	}
+	return dict
+}
+
+// Updates the keys and values from the given items
+func (d *SortedDict[K, V]) Update(items ...DictItem[K, V]) {
+	for _, item := range items {
+		d.Set(item.Key, item.Value)
+	}
+}
+
+// Returns an array of the values of the dictionary, ordered by key
+func (d *SortedDict[K, V]) Values() []V {
+	values := make([]V, 0, d.Len())
+	for _, item := range d.Items() {
+		values = append(values, item.Value)
+	}
+	return values
+}
@@ -0,0 +1,29 @@
+package godino
+
+import "fmt"
+
+func ExampleSortedDict() {
+	d := NewSortedDict[string, int]()
+	d.Set("carol", 3)
+	d.Set("alice", 1)
+	d.Set("bob", 2)
+	fmt.Println(d.Keys())
+	fmt.Println(d.Values())
+	// Output:
+	// [alice bob carol]
+	// [1 2 3]
+}
+
+func ExampleSortedDict_IRange() {
+	events := NewSortedDict(
+		DictItem[int, string]{Key: 1000, Value: "start"},
+		DictItem[int, string]{Key: 1500, Value: "pause"},
+		DictItem[int, string]{Key: 2100, Value: "resume"},
+		DictItem[int, string]{Key: 3000, Value: "stop"},
+	)
+	fmt.Println(events.IRange(1200, 2500).ToArray())
+	fmt.Println(events.Floor(2000))
+	// Output:
+	// [{1500 pause} {2100 resume}]
+	// {1500 pause} true
+}
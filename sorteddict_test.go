@@ -0,0 +1,115 @@
+package godino
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func getSortedDict() *SortedDict[string, int] {
+	return NewSortedDict(
+		DictItem[string, int]{Key: "orange", Value: 2},
+		DictItem[string, int]{Key: "apple", Value: 5},
+		DictItem[string, int]{Key: "banana", Value: 3},
+	)
+}
+
+func TestSortedDict(t *testing.T) {
+	t.Run("should keep keys sorted", func(t *testing.T) {
+		d := getSortedDict()
+		d.Set("cherry", 7)
+		d.Set("apple", 1)
+		assert.Equal(t, []string{"apple", "banana", "cherry", "orange"}, d.Keys())
+		assert.Equal(t, []int{1, 3, 7, 2}, d.Values())
+		assert.Equal(t, 4, d.Len())
+		assert.Equal(t, "[{apple 1} {banana 3} {cherry 7} {orange 2}]", d.String())
+	})
+
+	t.Run("should sort keys with a custom comparator", func(t *testing.T) {
+		d := NewSortedDictFunc(func(a, b string) int { return strings.Compare(b, a) },
+			DictItem[string, int]{Key: "a", Value: 1},
+			DictItem[string, int]{Key: "c", Value: 3},
+			DictItem[string, int]{Key: "b", Value: 2},
+		)
+		assert.Equal(t, []string{"c", "b", "a"}, d.Keys())
+	})
+
+	t.Run("should get and set values", func(t *testing.T) {
+		d := getSortedDict()
+		assert.Equal(t, 5, d.Get("apple"))
+		assert.Equal(t, 0, d.Get("mango"))
+		assert.Equal(t, 10, d.Get("mango", 10))
+		assert.True(t, d.Has("banana"))
+		assert.False(t, d.Has("mango"))
+		assert.Equal(t, 5, d.SetDefault("apple", 10))
+		assert.Equal(t, 10, d.SetDefault("mango", 10))
+		assert.Equal(t, 10, d.Get("mango"))
+		d.Update(DictItem[string, int]{Key: "kiwi", Value: 4}, DictItem[string, int]{Key: "apple", Value: 6})
+		assert.Equal(t, []DictItem[string, int]{
+			{Key: "apple", Value: 6},
+			{Key: "banana", Value: 3},
+			{Key: "kiwi", Value: 4},
+			{Key: "mango", Value: 10},
+			{Key: "orange", Value: 2},
+		}, d.Items())
+	})
+
+	t.Run("should delete and pop keys", func(t *testing.T) {
+		d := getSortedDict()
+		assert.True(t, d.Delete("banana"))
+		assert.False(t, d.Delete("banana"))
+		value, ok := d.Pop("apple")
+		assert.True(t, ok)
+		assert.Equal(t, 5, value)
+		value, ok = d.Pop("apple", 10)
+		assert.False(t, ok)
+		assert.Equal(t, 10, value)
+		assert.Equal(t, []string{"orange"}, d.Keys())
+	})
+
+	t.Run("should pop items by index", func(t *testing.T) {
+		d := getSortedDict()
+		item, err := d.PopItem()
+		assert.NoError(t, err)
+		assert.Equal(t, DictItem[string, int]{Key: "orange", Value: 2}, item)
+		item, err = d.PopItem(0)
+		assert.NoError(t, err)
+		assert.Equal(t, DictItem[string, int]{Key: "apple", Value: 5}, item)
+		_, err = d.PopItem(1)
+		assert.Error(t, err)
+		d.Clear()
+		_, err = d.PopItem()
+		assert.Error(t, err)
+	})
+
+	t.Run("should support range queries on keys", func(t *testing.T) {
+		d := getSortedDict()
+		item, ok := d.Floor("b")
+		assert.True(t, ok)
+		assert.Equal(t, DictItem[string, int]{Key: "apple", Value: 5}, item)
+		item, ok = d.Ceiling("b")
+		assert.True(t, ok)
+		assert.Equal(t, DictItem[string, int]{Key: "banana", Value: 3}, item)
+		_, ok = d.Ceiling("z")
+		assert.False(t, ok)
+		assert.Equal(t, 1, d.Rank("b"))
+		assert.Equal(t, 1, d.BisectLeft("banana"))
+		assert.Equal(t, 2, d.BisectRight("banana"))
+		assert.Equal(t, 2, d.Index("orange"))
+		assert.Equal(t, -1, d.Index("mango"))
+		item, err := d.Select(1)
+		assert.NoError(t, err)
+		assert.Equal(t, "banana", item.Key)
+		assert.Equal(t, []DictItem[string, int]{{Key: "banana", Value: 3}, {Key: "orange", Value: 2}}, d.IRange("b", "p").ToArray())
+		assert.Equal(t, []DictItem[string, int]{{Key: "apple", Value: 5}}, d.ISlice(0, 1).ToArray())
+	})
+
+	t.Run("should copy and convert the dictionary", func(t *testing.T) {
+		d := getSortedDict()
+		c := d.Copy()
+		c.Set("mango", 1)
+		assert.False(t, d.Has("mango"))
+		assert.Equal(t, getDict(), d.ToDict())
+	})
+}
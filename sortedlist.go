@@ -0,0 +1,132 @@
+package godino
+
+import (
+	"fmt"
+
+	"golang.org/x/exp/constraints"
+)
+
+// A list which keeps its elements in sorted order. Duplicates are allowed and equal elements keep the
+// order in which they were added. Insertions, deletions and lookups by value or index are O(log n).
+type SortedList[T any] struct {
+	tree sortedTree[T]
+}
+
+// Returns a new sorted list containing the values provided, ordered from smallest to largest
+func NewSortedList[T constraints.Ordered](values ...T) *SortedList[T] {
+	return NewSortedListFunc(compareOrdered[T], values...)
+}
+
+// Returns a new sorted list containing the values provided, ordered by the comparison function.
+// cmp should return a negative number when a < b, a positive number when a > b and zero when a == b.
+func NewSortedListFunc[T any](cmp func(a, b T) int, values ...T) *SortedList[T] {
+	list := &SortedList[T]{tree: sortedTree[T]{cmp: cmp}}
+	list.Add(values...)
+	return list
+}
+
+// Adds element(s) to the list
+func (list *SortedList[T]) Add(values ...T) {
+	for _, v := range values {
+		list.tree.insert(v)
+	}
+}
+
+// Returns the index at which the value would be inserted before any equal elements,
+// i.e. the number of elements less than the value
+func (list *SortedList[T]) BisectLeft(value T) int {
+	return list.tree.bisect(value, false)
+}
+
+// Returns the index at which the value would be inserted after any equal elements,
+// i.e. the number of elements less than or equal to the value
+func (list *SortedList[T]) BisectRight(value T) int {
+	return list.tree.bisect(value, true)
+}
+
+// Returns the smallest element greater than or equal to the value.
+// Returns a second boolean value which is false if there is no such element.
+func (list *SortedList[T]) Ceiling(value T) (T, bool) {
+	return list.tree.ceiling(value)
+}
+
+// Removes all the elements from the list
+func (list *SortedList[T]) Clear() {
+	list.tree.root = nil
+}
+
+// Returns a copy of the list
+func (list *SortedList[T]) Copy() *SortedList[T] {
+	return &SortedList[T]{tree: list.tree.copy()}
+}
+
+// Returns the number of elements equal to the value
+func (list *SortedList[T]) Count(value T) int {
+	return list.BisectRight(value) - list.BisectLeft(value)
+}
+
+// Returns the largest element less than or equal to the value.
+// Returns a second boolean value which is false if there is no such element.
+func (list *SortedList[T]) Floor(value T) (T, bool) {
+	return list.tree.floor(value)
+}
+
+// Returns true if the list contains the value
+func (list *SortedList[T]) Has(value T) bool {
+	_, ok := list.tree.find(value)
+	return ok
+}
+
+// Returns the index of the first element equal to the value, or -1 if the value is not present
+func (list *SortedList[T]) Index(value T) int {
+	i, _ := list.tree.find(value)
+	return i
+}
+
+// Returns an iterator over the elements between min and max inclusive, in sorted order.
+// The list must not be modified while iterating.
+func (list *SortedList[T]) IRange(min, max T) *Iterator[T] {
+	return list.tree.irange(min, max)
+}
+
+// Returns an iterator over the elements with indices from start up to but not including stop, in sorted order.
+// Indices are clamped to the bounds of the list. The list must not be modified while iterating.
+func (list *SortedList[T]) ISlice(start, stop int) *Iterator[T] {
+	return list.tree.iter(start, stop)
+}
+
+// Returns the number of elements in the list
+func (list *SortedList[T]) Len() int {
+	return list.tree.len()
+}
+
+// Returns the elements of the list in sorted order
+func (list *SortedList[T]) Members() []T {
+	return list.tree.values()
+}
+
+// Removes and returns the element at the given index, or the last element if no index is given.
+// Negative indices count back from the end of the list. Returns an error if the index is out of range.
+func (list *SortedList[T]) Pop(index ...int) (T, error) {
+	return list.tree.pop(index...)
+}
+
+// Returns the number of elements less than the value
+func (list *SortedList[T]) Rank(value T) int {
+	return list.BisectLeft(value)
+}
+
+// Removes the first element equal to the value. Returns true if the value was in the list
+func (list *SortedList[T]) Remove(value T) bool {
+	return list.tree.remove(value)
+}
+
+// Returns the element at the given index. Negative indices count back from the end of the list.
+// Returns an error if the index is out of range.
+func (list *SortedList[T]) Select(index int) (T, error) {
+	return list.tree.selectIndex(index)
+}
+
+func (list *SortedList[T]) String() string {
+	return fmt.Sprintf("%v", list.Members())
+}
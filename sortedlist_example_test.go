@@ -0,0 +1,40 @@
+package godino
+
+import "fmt"
+
+func ExampleSortedList() {
+	scores := NewSortedList(72, 95, 88, 61, 88)
+	fmt.Println(scores)
+	fmt.Println(scores.Select(-1))
+	fmt.Println(scores.Rank(88))
+	// Output:
+	// [61 72 88 88 95]
+	// 95 <nil>
+	// 2
+}
+
+func ExampleSortedList_Floor() {
+	list := NewSortedList(10, 20, 30)
+	fmt.Println(list.Floor(25))
+	fmt.Println(list.Ceiling(25))
+	fmt.Println(list.Ceiling(35))
+	// Output:
+	// 20 true
+	// 30 true
+	// 0 false
+}
+
+func ExampleSortedList_IRange() {
+	list := NewSortedList(1, 3, 5, 7, 9)
+	fmt.Println(list.IRange(2, 7).ToArray())
+	fmt.Println(list.ISlice(1, 3).ToArray())
+	// Output:
+	// [3 5 7]
+	// [3 5]
+}
+
+func ExampleNewSortedListFunc() {
+	list := NewSortedListFunc(func(a, b string) int { return len(a) - len(b) }, "banana", "fig", "apple")
+	fmt.Println(list)
+	// Output: [fig apple banana]
+}
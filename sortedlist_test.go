@@ -0,0 +1,122 @@
+package godino
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSortedList(t *testing.T) {
+	t.Run("should keep elements sorted", func(t *testing.T) {
+		list := NewSortedList(5, 1, 4, 1, 3)
+		list.Add(2, 6)
+		assert.Equal(t, []int{1, 1, 2, 3, 4, 5, 6}, list.Members())
+		assert.Equal(t, 7, list.Len())
+		assert.Equal(t, "[1 1 2 3 4 5 6]", list.String())
+	})
+
+	t.Run("should sort with a custom comparator", func(t *testing.T) {
+		list := NewSortedListFunc(func(a, b string) int { return len(a) - len(b) }, "ccc", "a", "bb", "dd")
+		assert.Equal(t, []string{"a", "bb", "dd", "ccc"}, list.Members())
+		reverse := NewSortedListFunc(func(a, b int) int { return b - a }, 1, 3, 2)
+		assert.Equal(t, []int{3, 2, 1}, reverse.Members())
+	})
+
+	t.Run("should find the insertion points of values", func(t *testing.T) {
+		list := NewSortedList(10, 20, 20, 30)
+		assert.Equal(t, 1, list.BisectLeft(20))
+		assert.Equal(t, 3, list.BisectRight(20))
+		assert.Equal(t, 0, list.BisectLeft(5))
+		assert.Equal(t, 4, list.BisectRight(35))
+		assert.Equal(t, 1, list.Rank(20))
+		assert.Equal(t, 2, list.Count(20))
+		assert.Equal(t, 0, list.Count(25))
+	})
+
+	t.Run("should find the floor and ceiling of values", func(t *testing.T) {
+		list := NewSortedList(10, 20, 30)
+		floor, ok := list.Floor(25)
+		assert.True(t, ok)
+		assert.Equal(t, 20, floor)
+		floor, ok = list.Floor(20)
+		assert.True(t, ok)
+		assert.Equal(t, 20, floor)
+		_, ok = list.Floor(5)
+		assert.False(t, ok)
+
+		ceiling, ok := list.Ceiling(25)
+		assert.True(t, ok)
+		assert.Equal(t, 30, ceiling)
+		ceiling, ok = list.Ceiling(10)
+		assert.True(t, ok)
+		assert.Equal(t, 10, ceiling)
+		_, ok = list.Ceiling(35)
+		assert.False(t, ok)
+	})
+
+	t.Run("should select elements by index", func(t *testing.T) {
+		list := NewSortedList(30, 10, 20)
+		v, err := list.Select(0)
+		assert.NoError(t, err)
+		assert.Equal(t, 10, v)
+		v, err = list.Select(-1)
+		assert.NoError(t, err)
+		assert.Equal(t, 30, v)
+		_, err = list.Select(3)
+		assert.EqualError(t, err, "Select() index out of range")
+		_, err = list.Select(-4)
+		assert.Error(t, err)
+	})
+
+	t.Run("should look up values", func(t *testing.T) {
+		list := NewSortedList(1, 2, 2, 3)
+		assert.True(t, list.Has(2))
+		assert.False(t, list.Has(4))
+		assert.Equal(t, 1, list.Index(2))
+		assert.Equal(t, -1, list.Index(4))
+	})
+
+	t.Run("should iterate over value and index ranges", func(t *testing.T) {
+		list := NewSortedList(1, 3, 3, 5, 7, 9)
+		assert.Equal(t, []int{3, 3, 5, 7}, list.IRange(2, 7).ToArray())
+		assert.Equal(t, []int{1, 3, 3}, list.IRange(1, 3).ToArray())
+		assert.Empty(t, list.IRange(7, 2).ToArray())
+		assert.Empty(t, list.IRange(10, 20).ToArray())
+		assert.Equal(t, []int{3, 5, 7}, list.ISlice(2, 5).ToArray())
+		assert.Equal(t, []int{1, 3, 3, 5, 7, 9}, list.ISlice(-5, 100).ToArray())
+	})
+
+	t.Run("should remove and pop elements", func(t *testing.T) {
+		list := NewSortedList(1, 2, 2, 3, 4)
+		assert.True(t, list.Remove(2))
+		assert.False(t, list.Remove(5))
+		assert.Equal(t, []int{1, 2, 3, 4}, list.Members())
+
+		v, err := list.Pop()
+		assert.NoError(t, err)
+		assert.Equal(t, 4, v)
+		v, err = list.Pop(0)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, v)
+		v, err = list.Pop(-2)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, v)
+		assert.Equal(t, []int{3}, list.Members())
+		_, err = list.Pop(1)
+		assert.EqualError(t, err, "Pop() index out of range")
+
+		list.Clear()
+		_, err = list.Pop()
+		assert.Error(t, err)
+		assert.Empty(t, list.Members())
+	})
+
+	t.Run("should return an independent copy", func(t *testing.T) {
+		list := NewSortedListFunc(func(a, b string) int { return strings.Compare(b, a) }, "a", "b")
+		c := list.Copy()
+		c.Add("c")
+		assert.Equal(t, []string{"b", "a"}, list.Members())
+		assert.Equal(t, []string{"c", "b", "a"}, c.Members())
+	})
+}
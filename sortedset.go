@@ -0,0 +1,210 @@
+package godino
+
+import (
+	"fmt"
+
+	"golang.org/x/exp/constraints"
+)
+
+// A set which keeps its unique elements in sorted order. Insertions, deletions and lookups by value
+// or index are O(log n). Two elements are considered equal when the comparison function returns zero.
+type SortedSet[T any] struct {
+	tree sortedTree[T]
+}
+
+// Returns a new sorted set containing the values provided, ordered from smallest to largest
+func NewSortedSet[T constraints.Ordered](values ...T) *SortedSet[T] {
+	return NewSortedSetFunc(compareOrdered[T], values...)
+}
+
+// Returns a new sorted set containing the values provided, ordered by the comparison function.
+// cmp should return a negative number when a < b, a positive number when a > b and zero when a == b.
+func NewSortedSetFunc[T any](cmp func(a, b T) int, values ...T) *SortedSet[T] {
+	set := &SortedSet[T]{tree: sortedTree[T]{cmp: cmp}}
+	set.Add(values...)
+	return set
+}
+
+func (set *SortedSet[T]) withMembers(members []T) *SortedSet[T] {
+	return &SortedSet[T]{tree: sortedTree[T]{root: buildTree(members), cmp: set.tree.cmp}}
+}
+
+// Adds element(s) to the set
+func (set *SortedSet[T]) Add(values ...T) {
+	for _, v := range values {
+		if !set.Has(v) {
+			set.tree.insert(v)
+		}
+	}
+}
+
+// Returns the index at which the value would be inserted before an equal element,
+// i.e. the number of elements less than the value
+func (set *SortedSet[T]) BisectLeft(value T) int {
+	return set.tree.bisect(value, false)
+}
+
+// Returns the index at which the value would be inserted after an equal element,
+// i.e. the number of elements less than or equal to the value
+func (set *SortedSet[T]) BisectRight(value T) int {
+	return set.tree.bisect(value, true)
+}
+
+// Returns the smallest element greater than or equal to the value.
+// Returns a second boolean value which is false if there is no such element.
+func (set *SortedSet[T]) Ceiling(value T) (T, bool) {
+	return set.tree.ceiling(value)
+}
+
+// Removes all the elements from the set
+func (set *SortedSet[T]) Clear() {
+	set.tree.root = nil
+}
+
+// Returns a copy of the set
+func (set *SortedSet[T]) Copy() *SortedSet[T] {
+	return &SortedSet[T]{tree: set.tree.copy()}
+}
+
+// Returns a set containing the difference between two or more sets
+func (set *SortedSet[T]) Difference(sets ...*SortedSet[T]) *SortedSet[T] {
+	members := set.Members()
+	for _, s := range sets {
+		members = set.tree.merge(members, s.Members(), true, false, false)
+	}
+	return set.withMembers(members)
+}
+
+// Removes the items in this set that are also included in one or more other sets
+func (set *SortedSet[T]) DifferenceUpdate(sets ...*SortedSet[T]) {
+	set.tree = set.Difference(sets...).tree
+}
+
+// Remove the specified item. If the item is not present this is a noop
+func (set *SortedSet[T]) Discard(value T) {
+	set.tree.remove(value)
+}
+
+// Returns true if the sets contain the same members
+func (set *SortedSet[T]) Equals(set2 *SortedSet[T]) bool {
+	return set.Len() == set2.Len() && set.IsSubset(set2)
+}
+
+// Returns the largest element less than or equal to the value.
+// Returns a second boolean value which is false if there is no such element.
+func (set *SortedSet[T]) Floor(value T) (T, bool) {
+	return set.tree.floor(value)
+}
+
+// Returns true if the set contains the specified element
+func (set *SortedSet[T]) Has(value T) bool {
+	_, ok := set.tree.find(value)
+	return ok
+}
+
+// Returns the index of the value in the set, or -1 if the value is not present
+func (set *SortedSet[T]) Index(value T) int {
+	i, _ := set.tree.find(value)
+	return i
+}
+
+// Returns a set, that is the intersection of two or more sets
+func (set *SortedSet[T]) Intersection(sets ...*SortedSet[T]) *SortedSet[T] {
+	members := set.Members()
+	for _, s := range sets {
+		members = set.tree.merge(members, s.Members(), false, true, false)
+	}
+	return set.withMembers(members)
+}
+
+// Removes the items in this set that are not present in other, specified set(s)
+func (set *SortedSet[T]) IntersectionUpdate(sets ...*SortedSet[T]) {
+	set.tree = set.Intersection(sets...).tree
+}
+
+// Returns an iterator over the elements between min and max inclusive, in sorted order.
+// The set must not be modified while iterating.
+func (set *SortedSet[T]) IRange(min, max T) *Iterator[T] {
+	return set.tree.irange(min, max)
+}
+
+// Returns an iterator over the elements with indices from start up to but not including stop, in sorted order.
+// Indices are clamped to the bounds of the set. The set must not be modified while iterating.
+func (set *SortedSet[T]) ISlice(start, stop int) *Iterator[T] {
+	return set.tree.iter(start, stop)
+}
+
+// Returns true if the sets have no common elements
+func (set1 *SortedSet[T]) IsDisjoint(set2 *SortedSet[T]) bool {
+	return len(set1.tree.merge(set1.Members(), set2.Members(), false, true, false)) == 0
+}
+
+// Returns true if the set is contained by the given set
+func (set1 *SortedSet[T]) IsSubset(set2 *SortedSet[T]) bool {
+	return len(set1.tree.merge(set1.Members(), set2.Members(), true, false, false)) == 0
+}
+
+// Returns true is the set contains the given set
+func (set1 *SortedSet[T]) IsSuperset(set2 *SortedSet[T]) bool {
+	return len(set1.tree.merge(set1.Members(), set2.Members(), false, false, true)) == 0
+}
+
+// Returns the number of elements in the set
+func (set *SortedSet[T]) Len() int {
+	return set.tree.len()
+}
+
+// Returns the elements contained by the set in sorted order
+func (set *SortedSet[T]) Members() []T {
+	return set.tree.values()
+}
+
+// Removes and returns the element at the given index, or the largest element if no index is given.
+// Negative indices count back from the end of the set. Returns an error if the index is out of range.
+func (set *SortedSet[T]) Pop(index ...int) (T, error) {
+	return set.tree.pop(index...)
+}
+
+// Returns the number of elements less than the value
+func (set *SortedSet[T]) Rank(value T) int {
+	return set.BisectLeft(value)
+}
+
+// Removes the specified element from the set. Returns true if the element was in the set
+func (set *SortedSet[T]) Remove(value T) bool {
+	return set.tree.remove(value)
+}
+
+// Returns the element at the given index. Negative indices count back from the end of the set.
+// Returns an error if the index is out of range.
+func (set *SortedSet[T]) Select(index int) (T, error) {
+	return set.tree.selectIndex(index)
+}
+
+func (set *SortedSet[T]) String() string {
+	return fmt.Sprintf("%v", set.Members())
+}
+
+// Returns a set that contains all items from both sets, except items that are present in both sets
+func (set1 *SortedSet[T]) SymmetricDifference(set2 *SortedSet[T]) *SortedSet[T] {
+	return set1.withMembers(set1.tree.merge(set1.Members(), set2.Members(), true, false, true))
+}
+
+// Removes the items that are present in both sets, and inserts the items that are not present in both sets
+func (set *SortedSet[T]) SymmetricDifferenceUpdate(sets ...*SortedSet[T]) {
+	for _, s := range sets {
+		set.tree = set.SymmetricDifference(s).tree
+	}
+}
+
+// Return a set that contains all items from both sets
+func (set1 *SortedSet[T]) Union(set2 *SortedSet[T]) *SortedSet[T] {
+	return set1.withMembers(set1.tree.merge(set1.Members(), set2.Members(), true, true, true))
+}
+
+// Adds all the items from the given sets
+func (set *SortedSet[T]) Update(sets ...*SortedSet[T]) {
+	for _, s := range sets {
+		set.tree = set.Union(s).tree
+	}
+}
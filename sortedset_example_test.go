@@ -0,0 +1,34 @@
+package godino
+
+import "fmt"
+
+func ExampleSortedSet() {
+	set := NewSortedSet("pear", "apple", "fig", "apple")
+	fmt.Println(set)
+	fmt.Println(set.Select(0))
+	fmt.Println(set.Ceiling("b"))
+	// Output:
+	// [apple fig pear]
+	// apple <nil>
+	// fig true
+}
+
+func ExampleSortedSet_Union() {
+	set1 := NewSortedSet(5, 1, 3)
+	set2 := NewSortedSet(4, 3, 2)
+	fmt.Println(set1.Union(set2))
+	fmt.Println(set1.Intersection(set2))
+	fmt.Println(set1.Difference(set2))
+	fmt.Println(set1.SymmetricDifference(set2))
+	// Output:
+	// [1 2 3 4 5]
+	// [3]
+	// [1 5]
+	// [1 2 4 5]
+}
+
+func ExampleSortedSet_IRange() {
+	set := NewSortedSet(2, 4, 6, 8, 10)
+	fmt.Println(set.IRange(3, 8).ToArray())
+	// Output: [4 6 8]
+}
@@ -0,0 +1,110 @@
+package godino
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSortedSet(t *testing.T) {
+	t.Run("should keep unique elements sorted", func(t *testing.T) {
+		set := NewSortedSet(5, 1, 4, 1, 3)
+		set.Add(3, 2)
+		assert.Equal(t, []int{1, 2, 3, 4, 5}, set.Members())
+		assert.Equal(t, 5, set.Len())
+		assert.True(t, set.Has(4))
+		assert.False(t, set.Has(6))
+		assert.Equal(t, "[1 2 3 4 5]", set.String())
+	})
+
+	t.Run("should treat elements as equal according to the comparator", func(t *testing.T) {
+		set := NewSortedSetFunc(func(a, b string) int { return strings.Compare(strings.ToLower(a), strings.ToLower(b)) }, "b", "A", "a", "B")
+		assert.Equal(t, []string{"A", "b"}, set.Members())
+		assert.True(t, set.Has("B"))
+	})
+
+	t.Run("should support range queries", func(t *testing.T) {
+		set := NewSortedSet(10, 20, 30, 40)
+		floor, ok := set.Floor(25)
+		assert.True(t, ok)
+		assert.Equal(t, 20, floor)
+		ceiling, ok := set.Ceiling(25)
+		assert.True(t, ok)
+		assert.Equal(t, 30, ceiling)
+		assert.Equal(t, 2, set.Rank(25))
+		assert.Equal(t, 1, set.BisectLeft(20))
+		assert.Equal(t, 2, set.BisectRight(20))
+		assert.Equal(t, 1, set.Index(20))
+		assert.Equal(t, -1, set.Index(25))
+		v, err := set.Select(-2)
+		assert.NoError(t, err)
+		assert.Equal(t, 30, v)
+		assert.Equal(t, []int{20, 30}, set.IRange(15, 30).ToArray())
+		assert.Equal(t, []int{30, 40}, set.ISlice(2, 10).ToArray())
+	})
+
+	t.Run("should remove elements", func(t *testing.T) {
+		set := NewSortedSet(1, 2, 3, 4)
+		assert.True(t, set.Remove(2))
+		assert.False(t, set.Remove(2))
+		set.Discard(3)
+		set.Discard(10)
+		v, err := set.Pop()
+		assert.NoError(t, err)
+		assert.Equal(t, 4, v)
+		assert.Equal(t, []int{1}, set.Members())
+		set.Clear()
+		_, err = set.Pop()
+		assert.Error(t, err)
+	})
+
+	t.Run("should perform set operations", func(t *testing.T) {
+		set1 := NewSortedSet(1, 2, 3, 4, 5)
+		set2 := NewSortedSet(4, 5, 6, 7)
+		set3 := NewSortedSet(1, 5)
+		assert.Equal(t, []int{1, 2, 3}, set1.Difference(set2).Members())
+		assert.Equal(t, []int{2, 3}, set1.Difference(set2, set3).Members())
+		assert.Equal(t, []int{4, 5}, set1.Intersection(set2).Members())
+		assert.Equal(t, []int{5}, set1.Intersection(set2, set3).Members())
+		assert.Equal(t, []int{1, 2, 3, 4, 5, 6, 7}, set1.Union(set2).Members())
+		assert.Equal(t, []int{1, 2, 3, 6, 7}, set1.SymmetricDifference(set2).Members())
+		assert.Equal(t, []int{1, 2, 3, 4, 5}, set1.Members())
+	})
+
+	t.Run("should update sets in place", func(t *testing.T) {
+		set := NewSortedSet(1, 2, 3)
+		set.Update(NewSortedSet(5), NewSortedSet(4))
+		assert.Equal(t, []int{1, 2, 3, 4, 5}, set.Members())
+		set.DifferenceUpdate(NewSortedSet(1), NewSortedSet(5))
+		assert.Equal(t, []int{2, 3, 4}, set.Members())
+		set.IntersectionUpdate(NewSortedSet(2, 3, 9))
+		assert.Equal(t, []int{2, 3}, set.Members())
+		set.SymmetricDifferenceUpdate(NewSortedSet(3, 4))
+		assert.Equal(t, []int{2, 4}, set.Members())
+		set.Add(3)
+		assert.Equal(t, []int{2, 3, 4}, set.Members())
+	})
+
+	t.Run("should compare sets", func(t *testing.T) {
+		set1 := NewSortedSet(1, 2)
+		set2 := NewSortedSet(1, 2, 3)
+		assert.True(t, set1.IsSubset(set2))
+		assert.False(t, set2.IsSubset(set1))
+		assert.True(t, set2.IsSuperset(set1))
+		assert.False(t, set1.IsSuperset(set2))
+		assert.False(t, set1.IsDisjoint(set2))
+		assert.True(t, set1.IsDisjoint(NewSortedSet(3, 4)))
+		assert.True(t, set1.Equals(NewSortedSet(2, 1)))
+		assert.False(t, set1.Equals(set2))
+		assert.False(t, set1.Equals(NewSortedSet(1, 3)))
+	})
+
+	t.Run("should return an independent copy", func(t *testing.T) {
+		set := NewSortedSet(1, 2)
+		c := set.Copy()
+		c.Add(0)
+		assert.Equal(t, []int{1, 2}, set.Members())
+		assert.Equal(t, []int{0, 1, 2}, c.Members())
+	})
+}
@@ -0,0 +1,366 @@
+package godino
+
+import (
+	"fmt"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Returns -1, 0 or 1 depending on whether a is less than, equal to or greater than b
+func compareOrdered[T constraints.Ordered](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// A node of an AVL tree augmented with subtree sizes so elements can be found by index
+type treeNode[E any] struct {
+	value       E
+	left, right *treeNode[E]
+	height      int
+	size        int
+}
+
+func (n *treeNode[E]) getHeight() int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+func (n *treeNode[E]) getSize() int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+func (n *treeNode[E]) update() *treeNode[E] {
+	n.height = 1 + n.left.getHeight()
+	if h := n.right.getHeight(); h >= n.height {
+		n.height = 1 + h
+	}
+	n.size = 1 + n.left.getSize() + n.right.getSize()
+	return n
+}
+
+func (n *treeNode[E]) rotateLeft() *treeNode[E] {
+	r := n.right
+	n.right = r.left
+	r.left = n.update()
+	return r.update()
+}
+
+func (n *treeNode[E]) rotateRight() *treeNode[E] {
+	l := n.left
+	n.left = l.right
+	l.right = n.update()
+	return l.update()
+}
+
+func (n *treeNode[E]) rebalance() *treeNode[E] {
+	n.update()
+	switch balance := n.left.getHeight() - n.right.getHeight(); {
+	case balance > 1:
+		if n.left.left.getHeight() < n.left.right.getHeight() {
+			n.left = n.left.rotateLeft()
+		}
+		return n.rotateRight()
+	case balance < -1:
+		if n.right.right.getHeight() < n.right.left.getHeight() {
+			n.right = n.right.rotateRight()
+		}
+		return n.rotateLeft()
+	}
+	return n
+}
+
+// A balanced binary search tree which keeps its elements sorted by a comparison function.
+// Equal elements are kept in the order they were inserted.
+type sortedTree[E any] struct {
+	root *treeNode[E]
+	cmp  func(a, b E) int
+}
+
+// Builds a balanced subtree from already sorted values
+func buildTree[E any](values []E) *treeNode[E] {
+	if len(values) == 0 {
+		return nil
+	}
+	mid := len(values) / 2
+	n := &treeNode[E]{value: values[mid], left: buildTree(values[:mid]), right: buildTree(values[mid+1:])}
+	return n.update()
+}
+
+func (t *sortedTree[E]) len() int {
+	return t.root.getSize()
+}
+
+// Inserts the value after any equal values
+func (t *sortedTree[E]) insert(value E) {
+	var insert func(n *treeNode[E]) *treeNode[E]
+	insert = func(n *treeNode[E]) *treeNode[E] {
+		if n == nil {
+			return &treeNode[E]{value: value, height: 1, size: 1}
+		}
+		if t.cmp(value, n.value) < 0 {
+			n.left = insert(n.left)
+		} else {
+			n.right = insert(n.right)
+		}
+		return n.rebalance()
+	}
+	t.root = insert(t.root)
+}
+
+func deleteMin[E any](n *treeNode[E]) (*treeNode[E], *treeNode[E]) {
+	if n.left == nil {
+		return n.right, n
+	}
+	var min *treeNode[E]
+	n.left, min = deleteMin(n.left)
+	return n.rebalance(), min
+}
+
+// Removes and returns the value at the given index, which must be in range
+func (t *sortedTree[E]) deleteAt(i int) E {
+	var deleted E
+	var deleteAt func(n *treeNode[E], i int) *treeNode[E]
+	deleteAt = func(n *treeNode[E], i int) *treeNode[E] {
+		switch ls := n.left.getSize(); {
+		case i < ls:
+			n.left = deleteAt(n.left, i)
+		case i > ls:
+			n.right = deleteAt(n.right, i-ls-1)
+		default:
+			deleted = n.value
+			if n.left == nil {
+				return n.right
+			}
+			if n.right == nil {
+				return n.left
+			}
+			right, successor := deleteMin(n.right)
+			successor.left, successor.right = n.left, right
+			n = successor
+		}
+		return n.rebalance()
+	}
+	t.root = deleteAt(t.root, i)
+	return deleted
+}
+
+// Returns the node at the given index, which must be in range
+func (t *sortedTree[E]) node(i int) *treeNode[E] {
+	n := t.root
+	for {
+		switch ls := n.left.getSize(); {
+		case i < ls:
+			n = n.left
+		case i > ls:
+			i -= ls + 1
+			n = n.right
+		default:
+			return n
+		}
+	}
+}
+
+// Returns the value at the given index, which must be in range
+func (t *sortedTree[E]) at(i int) E {
+	return t.node(i).value
+}
+
+// Returns the number of elements less than the value, or less than or equal to the value if inclusive is true
+func (t *sortedTree[E]) bisect(value E, inclusive bool) int {
+	i := 0
+	for n := t.root; n != nil; {
+		c := t.cmp(value, n.value)
+		if c < 0 || (c == 0 && !inclusive) {
+			n = n.left
+		} else {
+			i += n.left.getSize() + 1
+			n = n.right
+		}
+	}
+	return i
+}
+
+// Returns the index of the first element equal to the value and true, or -1 and false if there is none
+func (t *sortedTree[E]) find(value E) (int, bool) {
+	i := t.bisect(value, false)
+	if i < t.len() && t.cmp(t.at(i), value) == 0 {
+		return i, true
+	}
+	return -1, false
+}
+
+// Converts a possibly negative index to a non-negative one, returning an error if it is out of range
+func (t *sortedTree[E]) index(call string, i int) (int, error) {
+	n := t.len()
+	if i < 0 {
+		i += n
+	}
+	if i < 0 || i >= n {
+		return 0, fmt.Errorf("%s index out of range", call)
+	}
+	return i, nil
+}
+
+// Returns the smallest element greater than or equal to the value and true, or false if there is none
+func (t *sortedTree[E]) ceiling(value E) (E, bool) {
+	var ceiling E
+	i := t.bisect(value, false)
+	if i == t.len() {
+		return ceiling, false
+	}
+	return t.at(i), true
+}
+
+// Returns the largest element less than or equal to the value and true, or false if there is none
+func (t *sortedTree[E]) floor(value E) (E, bool) {
+	var floor E
+	i := t.bisect(value, true)
+	if i == 0 {
+		return floor, false
+	}
+	return t.at(i - 1), true
+}
+
+// Removes and returns the element at the given index, defaulting to the last element
+func (t *sortedTree[E]) pop(index ...int) (E, error) {
+	var value E
+	i := -1
+	if len(index) > 0 {
+		i = index[0]
+	}
+	i, err := t.index("Pop()", i)
+	if err != nil {
+		return value, err
+	}
+	return t.deleteAt(i), nil
+}
+
+// Removes the first element equal to the value, returning true if it was present
+func (t *sortedTree[E]) remove(value E) bool {
+	i, ok := t.find(value)
+	if ok {
+		t.deleteAt(i)
+	}
+	return ok
+}
+
+// Returns the element at the given index, which may be negative to count back from the end
+func (t *sortedTree[E]) selectIndex(index int) (E, error) {
+	var value E
+	i, err := t.index("Select()", index)
+	if err != nil {
+		return value, err
+	}
+	return t.at(i), nil
+}
+
+// Returns an iterator over the elements with indices in [start, stop), clamped to the bounds of the tree.
+// The tree must not be modified while iterating.
+func (t *sortedTree[E]) iter(start, stop int) *Iterator[E] {
+	if start < 0 {
+		start = 0
+	}
+	if n := t.len(); stop > n {
+		stop = n
+	}
+	if start >= stop {
+		return emptyIterator[E]()
+	}
+	var stack []*treeNode[E]
+	for n, i := t.root, start; n != nil; {
+		ls := n.left.getSize()
+		if i > ls {
+			i -= ls + 1
+			n = n.right
+			continue
+		}
+		stack = append(stack, n)
+		if i == ls {
+			break
+		}
+		n = n.left
+	}
+	remaining := stop - start
+	return NewIterator(func() (E, bool) {
+		var value E
+		if remaining == 0 || len(stack) == 0 {
+			return value, false
+		}
+		remaining--
+		n := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for child := n.right; child != nil; child = child.left {
+			stack = append(stack, child)
+		}
+		return n.value, true
+	})
+}
+
+// Returns an iterator over the elements between min and max inclusive
+func (t *sortedTree[E]) irange(min, max E) *Iterator[E] {
+	return t.iter(t.bisect(min, false), t.bisect(max, true))
+}
+
+func (t *sortedTree[E]) values() []E {
+	values := make([]E, 0, t.len())
+	var walk func(n *treeNode[E])
+	walk = func(n *treeNode[E]) {
+		if n != nil {
+			walk(n.left)
+			values = append(values, n.value)
+			walk(n.right)
+		}
+	}
+	walk(t.root)
+	return values
+}
+
+// Returns the sorted values which are in a but not b, in both, and in b but not a, as requested
+func (t *sortedTree[E]) merge(a, b []E, onlyA, both, onlyB bool) []E {
+	merged := make([]E, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		c := 0
+		switch {
+		case i == len(a):
+			c = 1
+		case j == len(b):
+			c = -1
+		default:
+			c = t.cmp(a[i], b[j])
+		}
+		switch {
+		case c < 0:
+			if onlyA {
+				merged = append(merged, a[i])
+			}
+			i++
+		case c > 0:
+			if onlyB {
+				merged = append(merged, b[j])
+			}
+			j++
+		default:
+			if both {
+				merged = append(merged, a[i])
+			}
+			i++
+			j++
+		}
+	}
+	return merged
+}
+
+func (t *sortedTree[E]) copy() sortedTree[E] {
+	return sortedTree[E]{root: buildTree(t.values()), cmp: t.cmp}
+}
@@ -0,0 +1,91 @@
+package godino
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func checkTree[E any](t *testing.T, n *treeNode[E]) {
+	if n == nil {
+		return
+	}
+	checkTree(t, n.left)
+	checkTree(t, n.right)
+	balance := n.left.getHeight() - n.right.getHeight()
+	assert.True(t, balance >= -1 && balance <= 1, "tree is unbalanced")
+	assert.Equal(t, 1+n.left.getSize()+n.right.getSize(), n.size, "subtree size is incorrect")
+}
+
+func sequence(n int) []int {
+	values := make([]int, n)
+	for i := range values {
+		values[i] = i
+	}
+	return values
+}
+
+func TestSortedTree(t *testing.T) {
+	t.Run("should stay balanced and sorted through random insertions and deletions", func(t *testing.T) {
+		r := rand.New(rand.NewSource(1))
+		tree := sortedTree[int]{cmp: compareOrdered[int]}
+		expected := []int{}
+		for i := 0; i < 2000; i++ {
+			if len(expected) > 0 && r.Intn(3) == 0 {
+				j := r.Intn(len(expected))
+				assert.Equal(t, expected[j], tree.deleteAt(j))
+				expected = append(expected[:j], expected[j+1:]...)
+			} else {
+				v := r.Intn(500)
+				tree.insert(v)
+				j := sort.SearchInts(expected, v+1)
+				expected = append(expected[:j], append([]int{v}, expected[j:]...)...)
+			}
+		}
+		checkTree(t, tree.root)
+		assert.Equal(t, expected, tree.values())
+		for i, v := range expected {
+			assert.Equal(t, v, tree.at(i))
+			assert.Equal(t, sort.SearchInts(expected, v), tree.bisect(v, false))
+			assert.Equal(t, sort.SearchInts(expected, v+1), tree.bisect(v, true))
+		}
+	})
+
+	t.Run("should keep equal elements in insertion order", func(t *testing.T) {
+		type pair struct{ key, order int }
+		tree := sortedTree[pair]{cmp: func(a, b pair) int { return compareOrdered(a.key, b.key) }}
+		for i, k := range []int{2, 1, 2, 1, 2} {
+			tree.insert(pair{k, i})
+		}
+		assert.Equal(t, []pair{{1, 1}, {1, 3}, {2, 0}, {2, 2}, {2, 4}}, tree.values())
+	})
+
+	t.Run("should build a balanced tree from sorted values", func(t *testing.T) {
+		values := sequence(100)
+		tree := sortedTree[int]{root: buildTree(values), cmp: compareOrdered[int]}
+		checkTree(t, tree.root)
+		assert.Equal(t, values, tree.values())
+	})
+
+	t.Run("should iterate over index ranges", func(t *testing.T) {
+		tree := sortedTree[int]{root: buildTree(sequence(10)), cmp: compareOrdered[int]}
+		for start := -1; start <= 11; start++ {
+			for stop := -1; stop <= 11; stop++ {
+				lo, hi := start, stop
+				if lo < 0 {
+					lo = 0
+				}
+				if hi > 10 {
+					hi = 10
+				}
+				expected := []int{}
+				for i := lo; i < hi; i++ {
+					expected = append(expected, i)
+				}
+				assert.Equal(t, expected, tree.iter(start, stop).ToArray(), "start %d stop %d", start, stop)
+			}
+		}
+	})
+}
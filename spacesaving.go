@@ -0,0 +1,153 @@
+package godino
+
+import (
+	"container/heap"
+	"sort"
+)
+
+type spaceSavingEntry[T comparable] struct {
+	element T
+	count   int
+	// The count of the element evicted to make room for this one, which bounds the overestimate
+	err int
+	// When the element started being tracked, used to break ties
+	seq   int
+	index int
+}
+
+// A min-heap of tracked elements ordered by count, so the least common can be evicted
+type spaceSavingHeap[T comparable] []*spaceSavingEntry[T]
+
+func (h spaceSavingHeap[T]) Len() int { return len(h) }
+
+func (h spaceSavingHeap[T]) Less(i, j int) bool {
+	if h[i].count != h[j].count {
+		return h[i].count < h[j].count
+	}
+	return h[i].seq > h[j].seq
+}
+
+func (h spaceSavingHeap[T]) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *spaceSavingHeap[T]) Push(x any) {
+	e := x.(*spaceSavingEntry[T])
+	e.index = len(*h)
+	*h = append(*h, e)
+}
+
+func (h *spaceSavingHeap[T]) Pop() any {
+	old := *h
+	e := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return e
+}
+
+// Tracks the most common elements of a stream using the Space-Saving algorithm, keeping at most a fixed
+// number of elements. When a new element arrives and the tracker is full, the least common element is
+// replaced and the new element inherits its count. Counts are therefore overestimates, but any element
+// whose true count exceeds Total() / capacity is guaranteed to be tracked.
+type SpaceSaving[T comparable] struct {
+	entries  map[T]*spaceSavingEntry[T]
+	heap     spaceSavingHeap[T]
+	capacity int
+	total    int
+	seq      int
+}
+
+// Returns a new tracker which keeps at most capacity elements. Panics if capacity is less than 1.
+func NewSpaceSaving[T comparable](capacity int) *SpaceSaving[T] {
+	if capacity < 1 {
+		panic("NewSpaceSaving() capacity must be at least 1")
+	}
+	return &SpaceSaving[T]{entries: make(map[T]*spaceSavingEntry[T]), capacity: capacity}
+}
+
+// Increments the count for the specified element
+func (s *SpaceSaving[T]) Add(value T) {
+	s.AddN(value, 1)
+}
+
+// Adds n to the count for the specified element. Panics if n is negative.
+func (s *SpaceSaving[T]) AddN(value T, n int) {
+	if n < 0 {
+		panic("AddN() called with a negative count")
+	}
+	s.total += n
+	if e, ok := s.entries[value]; ok {
+		e.count += n
+		heap.Fix(&s.heap, e.index)
+		return
+	}
+	s.seq++
+	if len(s.heap) < s.capacity {
+		e := &spaceSavingEntry[T]{element: value, count: n, seq: s.seq}
+		s.entries[value] = e
+		heap.Push(&s.heap, e)
+		return
+	}
+	e := s.heap[0]
+	delete(s.entries, e.element)
+	*e = spaceSavingEntry[T]{element: value, count: e.count + n, err: e.count, seq: s.seq}
+	s.entries[value] = e
+	heap.Fix(&s.heap, 0)
+}
+
+// Returns the maximum number of elements tracked
+func (s *SpaceSaving[T]) Capacity() int {
+	return s.capacity
+}
+
+// Returns the maximum amount by which the estimated count of the specified element exceeds its true count.
+// Returns 0 if the element is not tracked.
+func (s *SpaceSaving[T]) ErrorBound(value T) int {
+	if e, ok := s.entries[value]; ok {
+		return e.err
+	}
+	return 0
+}
+
+// Returns the estimated count of the specified element, which is never less than the true count for a
+// tracked element. Returns 0 if the element is not tracked.
+func (s *SpaceSaving[T]) Estimate(value T) int {
+	if e, ok := s.entries[value]; ok {
+		return e.count
+	}
+	return 0
+}
+
+// Returns true if the specified element is tracked
+func (s *SpaceSaving[T]) Has(value T) bool {
+	_, ok := s.entries[value]
+	return ok
+}
+
+// Returns the number of elements tracked
+func (s *SpaceSaving[T]) Len() int {
+	return len(s.heap)
+}
+
+// Returns the n elements with the largest estimated counts, and their counts. If n is less than 0 or
+// greater than the number of elements tracked, returns all tracked elements sorted by most common.
+// Elements with the same count are returned in the order they started being tracked.
+func (s *SpaceSaving[T]) MostCommon(n int) CounterEntries[T, int] {
+	if n < 0 || n > len(s.heap) {
+		n = len(s.heap)
+	}
+	tracked := Copy(s.heap)
+	sort.Slice(tracked, func(i, j int) bool { return tracked[i].seq < tracked[j].seq })
+	top := NLargestBy(n, tracked, func(e *spaceSavingEntry[T]) int { return e.count })
+	entries := make(CounterEntries[T, int], len(top))
+	for i, e := range top {
+		entries[i] = CounterEntry[T, int]{Element: e.element, Count: e.count}
+	}
+	return entries
+}
+
+// Returns the sum of the counts of all elements added, including those no longer tracked
+func (s *SpaceSaving[T]) Total() int {
+	return s.total
+}
@@ -0,0 +1,70 @@
+package godino
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpaceSaving(t *testing.T) {
+	t.Run("should count exactly while under capacity", func(t *testing.T) {
+		s := NewSpaceSaving[string](3)
+		for _, v := range []string{"a", "b", "a", "c", "a", "b"} {
+			s.Add(v)
+		}
+		expected := CounterEntries[string, int]{{"a", 3}, {"b", 2}, {"c", 1}}
+		assert.Equal(t, expected, s.MostCommon(-1))
+		assert.Equal(t, expected[:2], s.MostCommon(2))
+		assert.Equal(t, expected, s.MostCommon(10))
+		assert.Equal(t, 3, s.Len())
+		assert.Equal(t, 6, s.Total())
+		assert.Equal(t, 0, s.ErrorBound("a"))
+	})
+
+	t.Run("should replace the least common element when full", func(t *testing.T) {
+		s := NewSpaceSaving[string](2)
+		s.AddN("a", 5)
+		s.AddN("b", 2)
+		s.Add("c")
+		assert.False(t, s.Has("b"))
+		assert.True(t, s.Has("c"))
+		assert.Equal(t, 3, s.Estimate("c"))
+		assert.Equal(t, 2, s.ErrorBound("c"))
+		assert.Equal(t, 0, s.Estimate("b"))
+		assert.Equal(t, CounterEntries[string, int]{{"a", 5}, {"c", 3}}, s.MostCommon(-1))
+		assert.Equal(t, 2, s.Capacity())
+		assert.Equal(t, 8, s.Total())
+	})
+
+	t.Run("should find the heavy hitters of a skewed stream", func(t *testing.T) {
+		s := NewSpaceSaving[int](20)
+		counts := map[int]int{}
+		for i := 0; i < 10000; i++ {
+			v := i % 1000
+			if i%3 == 0 {
+				v = i % 5
+			}
+			s.Add(v)
+			counts[v]++
+		}
+		top := s.MostCommon(5)
+		assert.ElementsMatch(t, []int{0, 1, 2, 3, 4}, top.Keys())
+		for _, e := range top {
+			assert.GreaterOrEqual(t, e.Count, counts[e.Element])
+			assert.LessOrEqual(t, e.Count-s.ErrorBound(e.Element), counts[e.Element])
+		}
+	})
+
+	t.Run("should break ties by the order elements started being tracked", func(t *testing.T) {
+		s := NewSpaceSaving[string](3)
+		s.Add("c")
+		s.Add("a")
+		s.Add("b")
+		assert.Equal(t, []string{"c", "a", "b"}, s.MostCommon(-1).Keys())
+	})
+
+	t.Run("should panic with invalid arguments", func(t *testing.T) {
+		assert.Panics(t, func() { NewSpaceSaving[int](0) })
+		assert.Panics(t, func() { NewSpaceSaving[int](1).AddN(1, -1) })
+	})
+}
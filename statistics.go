@@ -0,0 +1,365 @@
+package godino
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"golang.org/x/exp/constraints"
+	"golang.org/x/exp/slices"
+)
+
+// Numbers which can be ordered, i.e. integers and floats
+type Real interface {
+	constraints.Integer | constraints.Float
+}
+
+// The method used by Quantiles to interpolate between data points
+type QuantileMethod int
+
+const (
+	// Treats the data as a sample from a population which may include values beyond those sampled
+	Exclusive QuantileMethod = iota
+	// Treats the data as the whole population, so the minimum and maximum are the 0th and 100th percentiles
+	Inclusive
+)
+
+func atLeast(call string, n, minimum int) error {
+	if n < minimum {
+		suffix := "s"
+		if minimum == 1 {
+			suffix = ""
+		}
+		return fmt.Errorf("%s expected at least %d data point%s, got %d", call, minimum, suffix, n)
+	}
+	return nil
+}
+
+func sortedCopy[T constraints.Ordered](data []T) []T {
+	sorted := Copy(data)
+	slices.Sort(sorted)
+	return sorted
+}
+
+func sumOfSquares[T Real](data []T) (float64, float64) {
+	mean := sumFloat(data) / float64(len(data))
+	ss := 0.0
+	for _, v := range data {
+		d := float64(v) - mean
+		ss += d * d
+	}
+	return ss, mean
+}
+
+func sumFloat[T Real](data []T) float64 {
+	return FSum(data...)
+}
+
+// Returns the Pearson correlation coefficient of two equal length arrays. Returns an error if the arrays
+// differ in length, have fewer than 2 elements, or either array is constant.
+func Correlation[T Real](x, y []T) (float64, error) {
+	if len(x) != len(y) {
+		return 0, errors.New("Correlation() requires that both inputs have the same number of data points")
+	}
+	if err := atLeast("Correlation()", len(x), 2); err != nil {
+		return 0, err
+	}
+	sxx, meanX := sumOfSquares(x)
+	syy, meanY := sumOfSquares(y)
+	if sxx == 0 || syy == 0 {
+		return 0, errors.New("Correlation() at least one of the inputs is constant")
+	}
+	sxy := 0.0
+	for i := range x {
+		sxy += (float64(x[i]) - meanX) * (float64(y[i]) - meanY)
+	}
+	return sxy / math.Sqrt(sxx*syy), nil
+}
+
+// Returns the sample covariance of two equal length arrays. Returns an error if the arrays differ in
+// length or have fewer than 2 elements.
+func Covariance[T Real](x, y []T) (float64, error) {
+	if len(x) != len(y) {
+		return 0, errors.New("Covariance() requires that both inputs have the same number of data points")
+	}
+	if err := atLeast("Covariance()", len(x), 2); err != nil {
+		return 0, err
+	}
+	meanX := sumFloat(x) / float64(len(x))
+	meanY := sumFloat(y) / float64(len(y))
+	sxy := 0.0
+	for i := range x {
+		sxy += (float64(x[i]) - meanX) * (float64(y[i]) - meanY)
+	}
+	return sxy / float64(len(x)-1), nil
+}
+
+// Returns the weighted arithmetic mean of the data as a float. If weights are given there must be one
+// for each data point. Returns an error if the data is empty, the lengths differ or the weights sum to zero.
+func FMean[T Real](data []T, weights ...T) (float64, error) {
+	if err := atLeast("FMean()", len(data), 1); err != nil {
+		return 0, err
+	}
+	if len(weights) == 0 {
+		return sumFloat(data) / float64(len(data)), nil
+	}
+	if len(weights) != len(data) {
+		return 0, errors.New("FMean() data and weights must be the same length")
+	}
+	total := sumFloat(weights)
+	if total == 0 {
+		return 0, errors.New("FMean() sum of weights must be non-zero")
+	}
+	sum := 0.0
+	for i := range data {
+		sum += float64(data[i]) * float64(weights[i])
+	}
+	return sum / total, nil
+}
+
+// Returns the geometric mean of the data. Returns an error if no data is given or any value is negative.
+func GeometricMean[T Real](data ...T) (float64, error) {
+	if err := atLeast("GeometricMean()", len(data), 1); err != nil {
+		return 0, err
+	}
+	logs := 0.0
+	for _, v := range data {
+		switch {
+		case v < 0:
+			return 0, errors.New("GeometricMean() requires non-negative data points")
+		case v == 0:
+			return 0, nil
+		}
+		logs += math.Log(float64(v))
+	}
+	return math.Exp(logs / float64(len(data))), nil
+}
+
+// Returns the harmonic mean of the data. Returns an error if no data is given or any value is negative.
+func HarmonicMean[T Real](data ...T) (float64, error) {
+	if err := atLeast("HarmonicMean()", len(data), 1); err != nil {
+		return 0, err
+	}
+	reciprocals := 0.0
+	for _, v := range data {
+		switch {
+		case v < 0:
+			return 0, errors.New("HarmonicMean() requires non-negative data points")
+		case v == 0:
+			return 0, nil
+		}
+		reciprocals += 1 / float64(v)
+	}
+	return float64(len(data)) / reciprocals, nil
+}
+
+// The slope and intercept of a line fitted by LinearRegression
+type Regression struct {
+	Slope     float64
+	Intercept float64
+}
+
+// Returns the slope and intercept of the simple linear regression of y on x, fitted by ordinary least squares.
+// Returns an error if the arrays differ in length, have fewer than 2 elements, or x is constant.
+func LinearRegression[T Real](x, y []T) (Regression, error) {
+	if len(x) != len(y) {
+		return Regression{}, errors.New("LinearRegression() requires that both inputs have the same number of data points")
+	}
+	if err := atLeast("LinearRegression()", len(x), 2); err != nil {
+		return Regression{}, err
+	}
+	sxx, meanX := sumOfSquares(x)
+	if sxx == 0 {
+		return Regression{}, errors.New("LinearRegression() x is constant")
+	}
+	meanY := sumFloat(y) / float64(len(y))
+	sxy := 0.0
+	for i := range x {
+		sxy += (float64(x[i]) - meanX) * (float64(y[i]) - meanY)
+	}
+	slope := sxy / sxx
+	return Regression{Slope: slope, Intercept: meanY - slope*meanX}, nil
+}
+
+// Returns the arithmetic mean of the data. Returns an error if no data is given.
+func Mean[T Real](data ...T) (float64, error) {
+	if err := atLeast("Mean()", len(data), 1); err != nil {
+		return 0, err
+	}
+	return sumFloat(data) / float64(len(data)), nil
+}
+
+// Returns the median of the data, taking the mean of the two middle values when the number of
+// data points is even. Returns an error if no data is given.
+func Median[T Real](data ...T) (float64, error) {
+	if err := atLeast("Median()", len(data), 1); err != nil {
+		return 0, err
+	}
+	sorted := sortedCopy(data)
+	n := len(sorted)
+	if n%2 == 1 {
+		return float64(sorted[n/2]), nil
+	}
+	return (float64(sorted[n/2-1]) + float64(sorted[n/2])) / 2, nil
+}
+
+// Returns the median of continuous data grouped into intervals of the given width centred on each
+// data point, using interpolation. Returns an error if no data is given.
+func MedianGrouped[T Real](interval float64, data ...T) (float64, error) {
+	if err := atLeast("MedianGrouped()", len(data), 1); err != nil {
+		return 0, err
+	}
+	sorted := sortedCopy(data)
+	n := len(sorted)
+	x := sorted[n/2]
+	// Find the range of values equal to the middle value
+	i, _ := slices.BinarySearch(sorted, x)
+	j := i
+	for j < n && sorted[j] == x {
+		j++
+	}
+	lower := float64(x) - interval/2
+	cf := float64(i)
+	f := float64(j - i)
+	return lower + interval*(float64(n)/2-cf)/f, nil
+}
+
+// Returns the high median of the data. When the number of data points is even, the larger of the
+// two middle values is returned. Returns an error if no data is given.
+func MedianHigh[T constraints.Ordered](data ...T) (T, error) {
+	var median T
+	if err := atLeast("MedianHigh()", len(data), 1); err != nil {
+		return median, err
+	}
+	sorted := sortedCopy(data)
+	return sorted[len(sorted)/2], nil
+}
+
+// Returns the low median of the data. When the number of data points is even, the smaller of the
+// two middle values is returned. Returns an error if no data is given.
+func MedianLow[T constraints.Ordered](data ...T) (T, error) {
+	var median T
+	if err := atLeast("MedianLow()", len(data), 1); err != nil {
+		return median, err
+	}
+	sorted := sortedCopy(data)
+	n := len(sorted)
+	if n%2 == 1 {
+		return sorted[n/2], nil
+	}
+	return sorted[n/2-1], nil
+}
+
+// Returns the most common value in the data. If there are multiple modes, the first one encountered
+// is returned. Returns an error if no data is given.
+func Mode[T comparable](data ...T) (T, error) {
+	var mode T
+	if err := atLeast("Mode()", len(data), 1); err != nil {
+		return mode, err
+	}
+	counter := NewCounter(data)
+	best := 0
+	for _, k := range counter.keys {
+		if count := counter.Get(k); count > best {
+			mode, best = k, count
+		}
+	}
+	return mode, nil
+}
+
+// Returns the most common values in the data in the order they were first encountered.
+// Returns an empty array if no data is given.
+func MultiMode[T comparable](data ...T) []T {
+	counter := NewCounter(data)
+	modes := []T{}
+	best := 0
+	for _, k := range counter.keys {
+		switch count := counter.Get(k); {
+		case count > best:
+			modes, best = []T{k}, count
+		case count == best:
+			modes = append(modes, k)
+		}
+	}
+	return modes
+}
+
+// Returns the population standard deviation of the data. Returns an error if no data is given.
+func PStdev[T Real](data ...T) (float64, error) {
+	if err := atLeast("PStdev()", len(data), 1); err != nil {
+		return 0, err
+	}
+	variance, _ := PVariance(data...)
+	return math.Sqrt(variance), nil
+}
+
+// Returns the population variance of the data. Returns an error if no data is given.
+func PVariance[T Real](data ...T) (float64, error) {
+	if err := atLeast("PVariance()", len(data), 1); err != nil {
+		return 0, err
+	}
+	ss, _ := sumOfSquares(data)
+	return ss / float64(len(data)), nil
+}
+
+// Divides the data into n continuous intervals with equal probability and returns the n-1 cut points
+// separating the intervals, e.g. n = 4 gives quartiles and n = 100 gives percentiles.
+// Returns an error if n is less than 1 or no data is given.
+func Quantiles[T Real](data []T, n int, method QuantileMethod) ([]float64, error) {
+	if n < 1 {
+		return nil, errors.New("Quantiles() n must be at least 1")
+	}
+	if err := atLeast("Quantiles()", len(data), 1); err != nil {
+		return nil, err
+	}
+	sorted := sortedCopy(data)
+	ld := len(sorted)
+	result := make([]float64, 0, n-1)
+	if ld == 1 {
+		for i := 1; i < n; i++ {
+			result = append(result, float64(sorted[0]))
+		}
+		return result, nil
+	}
+	switch method {
+	case Inclusive:
+		m := ld - 1
+		for i := 1; i < n; i++ {
+			j, delta := i*m/n, i*m%n
+			result = append(result, (float64(sorted[j])*float64(n-delta)+float64(sorted[j+1])*float64(delta))/float64(n))
+		}
+	case Exclusive:
+		m := ld + 1
+		for i := 1; i < n; i++ {
+			j := i * m / n
+			if j < 1 {
+				j = 1
+			} else if j > ld-1 {
+				j = ld - 1
+			}
+			delta := i*m - j*n
+			result = append(result, (float64(sorted[j-1])*float64(n-delta)+float64(sorted[j])*float64(delta))/float64(n))
+		}
+	default:
+		return nil, fmt.Errorf("Quantiles() unknown method %d", method)
+	}
+	return result, nil
+}
+
+// Returns the sample standard deviation of the data. Returns an error if fewer than 2 data points are given.
+func Stdev[T Real](data ...T) (float64, error) {
+	if err := atLeast("Stdev()", len(data), 2); err != nil {
+		return 0, err
+	}
+	variance, _ := Variance(data...)
+	return math.Sqrt(variance), nil
+}
+
+// Returns the sample variance of the data. Returns an error if fewer than 2 data points are given.
+func Variance[T Real](data ...T) (float64, error) {
+	if err := atLeast("Variance()", len(data), 2); err != nil {
+		return 0, err
+	}
+	ss, _ := sumOfSquares(data)
+	return ss / float64(len(data)-1), nil
+}
@@ -0,0 +1,125 @@
+package godino
+
+import "fmt"
+
+func ExampleMean() {
+	fmt.Println(Mean(1, 2, 3, 4, 4))
+	fmt.Println(Mean[int]())
+	// Output:
+	// 2.8 <nil>
+	// 0 Mean() expected at least 1 data point, got 0
+}
+
+func ExampleFMean() {
+	grades := []int{85, 92, 83, 91}
+	weights := []int{20, 20, 30, 30}
+	mean, _ := FMean(grades, weights...)
+	fmt.Printf("%.1f\n", mean)
+	// Output: 87.6
+}
+
+func ExampleGeometricMean() {
+	mean, _ := GeometricMean(54, 24, 36)
+	fmt.Printf("%.1f\n", mean)
+	// Output: 36.0
+}
+
+func ExampleHarmonicMean() {
+	// Average speed travelling 10km at 40km/h and 10km at 60km/h
+	mean, _ := HarmonicMean(40, 60)
+	fmt.Printf("%.1f\n", mean)
+	// Output: 48.0
+}
+
+func ExampleMedian() {
+	fmt.Println(Median(1, 3, 5))
+	fmt.Println(Median(1, 3, 5, 7))
+	// Output:
+	// 3 <nil>
+	// 4 <nil>
+}
+
+func ExampleMedianLow() {
+	fmt.Println(MedianLow(1, 3, 5, 7))
+	// Output: 3 <nil>
+}
+
+func ExampleMedianHigh() {
+	fmt.Println(MedianHigh(1, 3, 5, 7))
+	// Output: 5 <nil>
+}
+
+func ExampleMedianGrouped() {
+	fmt.Println(MedianGrouped(1, 1, 3, 3, 5, 7))
+	// Output: 3.25 <nil>
+}
+
+func ExampleMode() {
+	fmt.Println(Mode("red", "blue", "blue", "red", "green", "red"))
+	// Output: red <nil>
+}
+
+func ExampleMultiMode() {
+	fmt.Println(MultiMode([]rune("aabbbbccddddeeffffgg")...))
+	// Output: [98 100 102]
+}
+
+func ExamplePVariance() {
+	variance, _ := PVariance(0.0, 0.25, 0.25, 1.25, 1.5, 1.75, 2.75, 3.25)
+	fmt.Printf("%.4f\n", variance)
+	// Output: 1.2500
+}
+
+func ExampleVariance() {
+	variance, _ := Variance(2.75, 1.75, 1.25, 0.25, 0.5, 1.25, 3.5)
+	fmt.Printf("%.4f\n", variance)
+
+	_, err := Variance(1)
+	fmt.Println(err)
+	// Output:
+	// 1.3720
+	// Variance() expected at least 2 data points, got 1
+}
+
+func ExamplePStdev() {
+	stdev, _ := PStdev(1.5, 2.5, 2.5, 2.75, 3.25, 4.75)
+	fmt.Printf("%.4f\n", stdev)
+	// Output: 0.9869
+}
+
+func ExampleStdev() {
+	stdev, _ := Stdev(1.5, 2.5, 2.5, 2.75, 3.25, 4.75)
+	fmt.Printf("%.4f\n", stdev)
+	// Output: 1.0811
+}
+
+func ExampleQuantiles() {
+	data := []int{1, 2, 3, 4, 5}
+	fmt.Println(Quantiles(data, 4, Exclusive))
+	fmt.Println(Quantiles(data, 4, Inclusive))
+	// Output:
+	// [1.5 3 4.5] <nil>
+	// [2 3 4] <nil>
+}
+
+func ExampleCovariance() {
+	x := []int{1, 2, 3, 4, 5, 6, 7, 8, 9}
+	y := []int{1, 2, 3, 1, 2, 3, 1, 2, 3}
+	fmt.Println(Covariance(x, y))
+	// Output: 0.75 <nil>
+}
+
+func ExampleCorrelation() {
+	x := []int{1, 2, 3, 4, 5, 6, 7, 8, 9}
+	y := []int{9, 8, 7, 6, 5, 4, 3, 2, 1}
+	fmt.Println(Correlation(x, y))
+	// Output: -1 <nil>
+}
+
+func ExampleLinearRegression() {
+	years := []int{1971, 1975, 1979, 1982, 1983}
+	films := []int{1, 2, 3, 4, 5}
+	regression, _ := LinearRegression(years, films)
+	fmt.Printf("%.0f\n", regression.Slope*2019+regression.Intercept)
+	// Output: 16
+}
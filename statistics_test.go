@@ -0,0 +1,225 @@
+package godino
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMean(t *testing.T) {
+	t.Run("should return the mean and no error for a non-empty list", func(t *testing.T) {
+		mean, err := Mean(1, 2, 3, 4, 4)
+		assert.NoError(t, err)
+		assert.Equal(t, 2.8, mean)
+	})
+
+	t.Run("should return zero and an error for an empty list", func(t *testing.T) {
+		mean, err := Mean[int]()
+		assert.Equal(t, 0.0, mean)
+		assert.Error(t, err)
+	})
+}
+
+func TestFMean(t *testing.T) {
+	mean, err := FMean([]float64{3.5, 4.0, 5.25})
+	assert.NoError(t, err)
+	assert.Equal(t, 4.25, mean)
+
+	weighted, err := FMean([]int{85, 92, 83, 91}, 20, 20, 30, 30)
+	assert.NoError(t, err)
+	assert.InDelta(t, 87.6, weighted, 1e-9)
+
+	_, err = FMean([]int{})
+	assert.Error(t, err)
+	_, err = FMean([]int{1, 2}, 1)
+	assert.Error(t, err)
+	_, err = FMean([]int{1, 2}, 1, -1)
+	assert.Error(t, err)
+}
+
+func TestGeometricAndHarmonicMean(t *testing.T) {
+	g, err := GeometricMean(54, 24, 36)
+	assert.NoError(t, err)
+	assert.InDelta(t, 36.0, g, 1e-9)
+
+	h, err := HarmonicMean(40, 60)
+	assert.NoError(t, err)
+	assert.InDelta(t, 48.0, h, 1e-9)
+
+	g, err = GeometricMean(0, 5)
+	assert.NoError(t, err)
+	assert.Equal(t, 0.0, g)
+
+	_, err = GeometricMean(-1, 5)
+	assert.Error(t, err)
+	_, err = HarmonicMean(-1, 5)
+	assert.Error(t, err)
+	_, err = GeometricMean[int]()
+	assert.Error(t, err)
+	_, err = HarmonicMean[int]()
+	assert.Error(t, err)
+}
+
+func TestMedian(t *testing.T) {
+	median, err := Median(1, 3, 5)
+	assert.NoError(t, err)
+	assert.Equal(t, 3.0, median)
+
+	median, err = Median(1, 3, 5, 7)
+	assert.NoError(t, err)
+	assert.Equal(t, 4.0, median)
+
+	low, err := MedianLow(1, 3, 5, 7)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, low)
+
+	high, err := MedianHigh(1, 3, 5, 7)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, high)
+
+	word, err := MedianLow("b", "c", "a")
+	assert.NoError(t, err)
+	assert.Equal(t, "b", word)
+
+	grouped, err := MedianGrouped(1, 52, 52, 53, 54)
+	assert.NoError(t, err)
+	assert.Equal(t, 52.5, grouped)
+
+	grouped, err = MedianGrouped(1, 1, 3, 3, 5, 7)
+	assert.NoError(t, err)
+	assert.Equal(t, 3.25, grouped)
+
+	_, err = Median[int]()
+	assert.Error(t, err)
+	_, err = MedianLow[int]()
+	assert.Error(t, err)
+	_, err = MedianHigh[int]()
+	assert.Error(t, err)
+	_, err = MedianGrouped[int](1)
+	assert.Error(t, err)
+}
+
+func TestMode(t *testing.T) {
+	mode, err := Mode(1, 1, 2, 3, 3, 3, 3, 4)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, mode)
+
+	first, err := Mode("red", "blue", "blue", "red")
+	assert.NoError(t, err)
+	assert.Equal(t, "red", first)
+
+	_, err = Mode[int]()
+	assert.Error(t, err)
+
+	assert.Equal(t, []string{"a", "b"}, MultiMode("a", "a", "b", "b", "c"))
+	assert.Equal(t, []int{}, MultiMode[int]())
+}
+
+func TestVariance(t *testing.T) {
+	data := []float64{2.75, 1.75, 1.25, 0.25, 0.5, 1.25, 3.5}
+
+	variance, err := Variance(data...)
+	assert.NoError(t, err)
+	assert.InDelta(t, 1.3720238095238095, variance, 1e-12)
+
+	pvariance, err := PVariance(data...)
+	assert.NoError(t, err)
+	assert.InDelta(t, 1.1760204081632653, pvariance, 1e-12)
+
+	stdev, err := Stdev(1.5, 2.5, 2.5, 2.75, 3.25, 4.75)
+	assert.NoError(t, err)
+	assert.InDelta(t, 1.0810874155219827, stdev, 1e-12)
+
+	pstdev, err := PStdev(1.5, 2.5, 2.5, 2.75, 3.25, 4.75)
+	assert.NoError(t, err)
+	assert.InDelta(t, 0.986893273527251, pstdev, 1e-12)
+
+	_, err = Variance(1)
+	assert.Error(t, err)
+	_, err = Stdev(1)
+	assert.Error(t, err)
+	_, err = PVariance[int]()
+	assert.Error(t, err)
+	_, err = PStdev[int]()
+	assert.Error(t, err)
+}
+
+func TestQuantiles(t *testing.T) {
+	data := []int{105, 129, 87, 86, 111, 111, 89, 81, 108, 92, 110, 100, 75, 105, 103, 109, 76, 119, 99, 91, 103, 129, 106, 101, 84, 111, 74, 87, 86, 103, 103, 106, 86, 111, 75, 87, 102, 121, 111, 88, 89, 101, 106, 95, 103, 107, 101, 81, 109, 104}
+
+	deciles, err := Quantiles(data, 10, Exclusive)
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{81, 86.2, 89, 99.4, 102.5, 103.6, 106, 109.8, 111}, roundAll(deciles))
+
+	quartiles, err := Quantiles([]int{1, 2, 3, 4, 5}, 4, Inclusive)
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{2, 3, 4}, quartiles)
+
+	quartiles, err = Quantiles([]int{1, 2, 3, 4, 5}, 4, Exclusive)
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{1.5, 3, 4.5}, quartiles)
+
+	single, err := Quantiles([]int{7}, 4, Exclusive)
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{7, 7, 7}, single)
+
+	none, err := Quantiles([]int{1, 2}, 1, Exclusive)
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{}, none)
+
+	_, err = Quantiles([]int{}, 4, Exclusive)
+	assert.Error(t, err)
+	_, err = Quantiles([]int{1, 2}, 0, Exclusive)
+	assert.Error(t, err)
+	_, err = Quantiles([]int{1, 2}, 4, QuantileMethod(5))
+	assert.Error(t, err)
+}
+
+func roundAll(values []float64) []float64 {
+	rounded := make([]float64, len(values))
+	for i, v := range values {
+		rounded[i] = float64(int(v*10+0.5)) / 10
+	}
+	return rounded
+}
+
+func TestCorrelationCovarianceRegression(t *testing.T) {
+	x := []int{1, 2, 3, 4, 5, 6, 7, 8, 9}
+	y := []int{1, 2, 3, 1, 2, 3, 1, 2, 3}
+
+	covariance, err := Covariance(x, y)
+	assert.NoError(t, err)
+	assert.InDelta(t, 0.75, covariance, 1e-12)
+
+	correlation, err := Correlation(x, x)
+	assert.NoError(t, err)
+	assert.InDelta(t, 1.0, correlation, 1e-12)
+
+	reversed := []int{9, 8, 7, 6, 5, 4, 3, 2, 1}
+	correlation, err = Correlation(x, reversed)
+	assert.NoError(t, err)
+	assert.InDelta(t, -1.0, correlation, 1e-12)
+
+	regression, err := LinearRegression([]float64{1, 2, 3, 4}, []float64{3, 5, 7, 9})
+	assert.NoError(t, err)
+	assert.InDelta(t, 2.0, regression.Slope, 1e-12)
+	assert.InDelta(t, 1.0, regression.Intercept, 1e-12)
+
+	_, err = Covariance([]int{1}, []int{1})
+	assert.Error(t, err)
+	_, err = Covariance([]int{1, 2}, []int{1})
+	assert.Error(t, err)
+	_, err = Correlation([]int{1, 1}, []int{1, 2})
+	assert.Error(t, err)
+	_, err = LinearRegression([]int{1, 1}, []int{1, 2})
+	assert.Error(t, err)
+	_, err = LinearRegression([]int{1, 2}, []int{1})
+	assert.Error(t, err)
+}
+
+func TestMeanOfLargeValues(t *testing.T) {
+	mean, err := Mean(math.MaxFloat64, math.MaxFloat64, -math.MaxFloat64)
+	assert.NoError(t, err)
+	assert.Equal(t, math.MaxFloat64/3, mean)
+}
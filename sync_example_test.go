@@ -0,0 +1,67 @@
+package godino
+
+import (
+	"fmt"
+	"sync"
+)
+
+func ExampleSyncDict_GetOrSet() {
+	cache := NewSyncDict[string, int]()
+	fmt.Println(cache.GetOrSet("a", 1))
+	fmt.Println(cache.GetOrSet("a", 2))
+	// Output:
+	// 1 false
+	// 1 true
+}
+
+func ExampleSyncDict_CompareAndSwap() {
+	d := NewSyncDict(Dict[string, int]{"version": 1})
+	fmt.Println(d.CompareAndSwap("version", 2, 3))
+	fmt.Println(d.CompareAndSwap("version", 1, 2))
+	fmt.Println(d.Get("version"))
+	// Output:
+	// false
+	// true
+	// 2
+}
+
+func ExampleSyncSet_AddIfAbsent() {
+	seen := NewSyncSet[string]()
+	var wg sync.WaitGroup
+	first := make([]bool, 3)
+	for i := range first {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			first[i] = seen.AddIfAbsent("job")
+		}(i)
+	}
+	wg.Wait()
+	fmt.Println(Count(first, true))
+	// Output: 1
+}
+
+func ExampleSyncCounter() {
+	c := NewSyncCounter([]string{})
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c.Add("hits")
+		}()
+	}
+	wg.Wait()
+	fmt.Println(c.Get("hits"))
+	// Output: 10
+}
+
+func ExampleSyncDeque_PopLeft() {
+	d := NewSyncDeque[int]()
+	d.PushRight(1)
+	fmt.Println(d.PopLeft())
+	fmt.Println(d.PopLeft())
+	// Output:
+	// 1 true
+	// 0 false
+}
@@ -0,0 +1,95 @@
+package godino
+
+import (
+	"fmt"
+	"sync"
+)
+
+// A counter which is safe for concurrent use by multiple goroutines
+type SyncCounter[T comparable] struct {
+	// Elements, Entries, LeastCommon and MostCommon fill the counter's caches, so they need the write lock
+	mu      sync.RWMutex
+	counter Counter[T]
+}
+
+// Returns a new concurrency safe counter containing the counts of the specified elements
+func NewSyncCounter[T comparable](values []T) *SyncCounter[T] {
+	return &SyncCounter[T]{counter: NewCounter(values)}
+}
+
+// Increments the count for the specified element
+func (c *SyncCounter[T]) Add(value T) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.counter.Add(value)
+}
+
+// Returns an iterator over the elements, repeating each as many times as its count, in the order they
+// were added. The iterator is a snapshot, so is unaffected by later changes to the counter.
+func (c *SyncCounter[T]) Elements() *Iterator[T] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.counter.Elements()
+}
+
+// Returns the elements and their counts in the order they were added
+func (c *SyncCounter[T]) Entries() CounterEntries[T, int] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return Copy(c.counter.Entries())
+}
+
+// Returns the count of the specified element
+func (c *SyncCounter[T]) Get(value T) int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.counter.Get(value)
+}
+
+// Returns the n least common elements and their counts, from least to most common. If n is less than 0
+// or greater than the number of elements, returns all elements.
+func (c *SyncCounter[T]) LeastCommon(n int) CounterEntries[T, int] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return Copy(c.counter.LeastCommon(n))
+}
+
+// Returns the n most common elements and their counts. If n is less than 0 or greater than the number
+// of elements, returns all elements sorted by most common.
+func (c *SyncCounter[T]) MostCommon(n int) CounterEntries[T, int] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return Copy(c.counter.MostCommon(n))
+}
+
+func (c *SyncCounter[T]) String() string {
+	return fmt.Sprintf("%v", c.Entries())
+}
+
+// Decrements the count of the specified element
+func (c *SyncCounter[T]) Subtract(value T) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.counter.Subtract(value)
+}
+
+// Returns a copy of the counter's current contents as a Counter
+func (c *SyncCounter[T]) ToCounter() Counter[T] {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return Counter[T]{c.counter.copy()}
+}
+
+// Returns the sum of the counts all of all elements
+func (c *SyncCounter[T]) Total() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.counter.Total()
+}
+
+// Adds the counts of the elements in the provided arrays to the counter
+func (c *SyncCounter[T]) Update(arrs ...[]T) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.counter.Update(arrs...)
+}
@@ -0,0 +1,56 @@
+package godino
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyncCounter(t *testing.T) {
+	t.Run("should behave like a counter", func(t *testing.T) {
+		c := NewSyncCounter([]string{"a", "b", "a"})
+		c.Add("c")
+		c.Update([]string{"a", "c"})
+		c.Subtract("b")
+		assert.Equal(t, 3, c.Get("a"))
+		assert.Equal(t, 0, c.Get("b"))
+		assert.Equal(t, 5, c.Total())
+		assert.Equal(t, CounterEntries[string, int]{{"a", 3}, {"b", 0}, {"c", 2}}, c.Entries())
+		assert.Equal(t, []string{"a", "a", "a", "c", "c"}, c.Elements().ToArray())
+		assert.Equal(t, CounterEntries[string, int]{{"a", 3}}, c.MostCommon(1))
+		assert.Equal(t, CounterEntries[string, int]{{"b", 0}, {"c", 2}}, c.LeastCommon(2))
+		assert.Equal(t, "[{a 3} {b 0} {c 2}]", c.String())
+	})
+
+	t.Run("should return an independent copy as a counter", func(t *testing.T) {
+		c := NewSyncCounter([]string{"a", "b", "a"})
+		counter := c.ToCounter()
+		counter.Add("c")
+		assert.Equal(t, 0, c.Get("c"))
+		assert.Equal(t, 2, counter.Get("a"))
+		assert.Equal(t, 4, counter.Total())
+	})
+
+	t.Run("should be safe for concurrent use", func(t *testing.T) {
+		c := NewSyncCounter([]int{})
+		var wg sync.WaitGroup
+		for g := 0; g < 8; g++ {
+			wg.Add(1)
+			go func(g int) {
+				defer wg.Done()
+				for i := 0; i < 200; i++ {
+					c.Add(i % 4)
+					c.Entries()
+					c.Elements().ToArray()
+					c.MostCommon(-1)
+					c.LeastCommon(2)
+					c.Get(g)
+				}
+			}(g)
+		}
+		wg.Wait()
+		assert.Equal(t, 1600, c.Total())
+		assert.Equal(t, 400, c.Get(3))
+	})
+}
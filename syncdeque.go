@@ -0,0 +1,136 @@
+package godino
+
+import (
+	"fmt"
+	"sync"
+)
+
+// A deque which is safe for concurrent use by multiple goroutines. Unlike Deque, peeking at or popping
+// from an empty deque returns false rather than panicking, since another goroutine may empty the
+// deque between checking its length and popping.
+type SyncDeque[T any] struct {
+	mu    sync.RWMutex
+	deque *Deque[T]
+}
+
+// Returns a concurrency safe deque with the specified minimum capacity (defaults to 1)
+func NewSyncDeque[T any](capacity ...int) *SyncDeque[T] {
+	return &SyncDeque[T]{deque: NewDeque[T](capacity...)}
+}
+
+// Removes all elements from the deque
+func (d *SyncDeque[T]) Clear() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.deque.Clear()
+}
+
+// Returns an array containing all elements from the deque
+func (d *SyncDeque[T]) Elements() []T {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.deque.Elements()
+}
+
+// Adds the given elements to the left side of the deque.
+// The series of left pushes results in reversing the order of given elements.
+func (d *SyncDeque[T]) ExtendLeft(arr []T) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.deque.ExtendLeft(arr)
+}
+
+// Adds the given elements to the right side of the deque
+func (d *SyncDeque[T]) ExtendRight(arr []T) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.deque.ExtendRight(arr)
+}
+
+// Returns the number of elements in the deque
+func (d *SyncDeque[T]) Len() int {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.deque.Len()
+}
+
+// Returns the first element (leftmost) in the deque.
+// Returns a second boolean value which is false if the deque is empty.
+func (d *SyncDeque[T]) PeekLeft() (T, bool) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	var value T
+	if d.deque.Len() == 0 {
+		return value, false
+	}
+	return d.deque.PeekLeft(), true
+}
+
+// Returns the last element (rightmost) of the deque.
+// Returns a second boolean value which is false if the deque is empty.
+func (d *SyncDeque[T]) PeekRight() (T, bool) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	var value T
+	if d.deque.Len() == 0 {
+		return value, false
+	}
+	return d.deque.PeekRight(), true
+}
+
+// Removes the first element (leftmost) from the deque and returns it.
+// Returns a second boolean value which is false if the deque is empty.
+func (d *SyncDeque[T]) PopLeft() (T, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	var value T
+	if d.deque.Len() == 0 {
+		return value, false
+	}
+	return d.deque.PopLeft(), true
+}
+
+// Removes the last element (rightmost) from the deque and returns it.
+// Returns a second boolean value which is false if the deque is empty.
+func (d *SyncDeque[T]) PopRight() (T, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	var value T
+	if d.deque.Len() == 0 {
+		return value, false
+	}
+	return d.deque.PopRight(), true
+}
+
+// Adds an element to the beginning of the deque
+func (d *SyncDeque[T]) PushLeft(value T) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.deque.PushLeft(value)
+}
+
+// Adds an element to the end of the deque
+func (d *SyncDeque[T]) PushRight(value T) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.deque.PushRight(value)
+}
+
+// Reverses the order of the elements in the deque
+func (d *SyncDeque[T]) Reverse() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.deque.Reverse()
+}
+
+// Rotate the deque n elements to the right.
+// If n is negative, the deque is rotated to the left.
+func (d *SyncDeque[T]) Rotate(n int) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.deque.Rotate(n)
+}
+
+func (d *SyncDeque[T]) String() string {
+	return fmt.Sprintf("%v", d.Elements())
+}
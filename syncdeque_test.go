@@ -0,0 +1,77 @@
+package godino
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyncDeque(t *testing.T) {
+	t.Run("should behave like a deque", func(t *testing.T) {
+		d := NewSyncDeque[int]()
+		d.PushRight(2)
+		d.PushLeft(1)
+		d.ExtendRight([]int{3, 4})
+		d.ExtendLeft([]int{0})
+		assert.Equal(t, []int{0, 1, 2, 3, 4}, d.Elements())
+		d.Rotate(1)
+		assert.Equal(t, []int{1, 2, 3, 4, 0}, d.Elements())
+		d.Reverse()
+		assert.Equal(t, "[0 4 3 2 1]", d.String())
+		assert.Equal(t, 5, d.Len())
+
+		left, ok := d.PeekLeft()
+		assert.True(t, ok)
+		assert.Equal(t, 0, left)
+		right, ok := d.PeekRight()
+		assert.True(t, ok)
+		assert.Equal(t, 1, right)
+		left, ok = d.PopLeft()
+		assert.True(t, ok)
+		assert.Equal(t, 0, left)
+		right, ok = d.PopRight()
+		assert.True(t, ok)
+		assert.Equal(t, 1, right)
+		d.Clear()
+		assert.Equal(t, 0, d.Len())
+	})
+
+	t.Run("should return false rather than panic when empty", func(t *testing.T) {
+		d := NewSyncDeque[int]()
+		_, ok := d.PeekLeft()
+		assert.False(t, ok)
+		_, ok = d.PeekRight()
+		assert.False(t, ok)
+		_, ok = d.PopLeft()
+		assert.False(t, ok)
+		_, ok = d.PopRight()
+		assert.False(t, ok)
+	})
+
+	t.Run("should be safe for concurrent use", func(t *testing.T) {
+		d := NewSyncDeque[int]()
+		var wg sync.WaitGroup
+		popped := make([]int, 4)
+		for g := 0; g < 4; g++ {
+			wg.Add(2)
+			go func() {
+				defer wg.Done()
+				for i := 0; i < 500; i++ {
+					d.PushRight(i)
+				}
+			}()
+			go func(g int) {
+				defer wg.Done()
+				for i := 0; i < 250; i++ {
+					if _, ok := d.PopLeft(); ok {
+						popped[g]++
+					}
+					d.Len()
+				}
+			}(g)
+		}
+		wg.Wait()
+		assert.Equal(t, 2000, d.Len()+Sum(popped...))
+	})
+}
@@ -0,0 +1,170 @@
+package godino
+
+import (
+	"fmt"
+	"sync"
+)
+
+// A dictionary which is safe for concurrent use by multiple goroutines
+type SyncDict[K comparable, V any] struct {
+	mu   sync.RWMutex
+	dict Dict[K, V]
+}
+
+// Returns a new concurrency safe dictionary containing a copy of the given dictionary's items
+func NewSyncDict[K comparable, V any](dicts ...Dict[K, V]) *SyncDict[K, V] {
+	d := &SyncDict[K, V]{dict: Dict[K, V]{}}
+	for _, dict := range dicts {
+		d.dict.Update(dict)
+	}
+	return d
+}
+
+// Removes all elements from the dictionary
+func (d *SyncDict[K, V]) Clear() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.dict = Dict[K, V]{}
+}
+
+// Deletes the key if its current value is equal to old, as a single atomic operation.
+// Returns true if the key was deleted. Panics if the value type is not comparable.
+func (d *SyncDict[K, V]) CompareAndDelete(key K, old V) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	current, ok := d.dict[key]
+	if !ok || any(current) != any(old) {
+		return false
+	}
+	delete(d.dict, key)
+	return true
+}
+
+// Sets the value for the key to new if its current value is equal to old, as a single atomic operation.
+// Returns true if the value was swapped. Panics if the value type is not comparable.
+func (d *SyncDict[K, V]) CompareAndSwap(key K, old, new V) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	current, ok := d.dict[key]
+	if !ok || any(current) != any(old) {
+		return false
+	}
+	d.dict[key] = new
+	return true
+}
+
+// Removes the given key from the dictionary
+func (d *SyncDict[K, V]) Delete(key K) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	delete(d.dict, key)
+}
+
+// Returns the value associated with given key.
+// In the case the the key is not present, a fallback value is returned if provided.
+// Otherwise the zero-value for the value type is returned.
+func (d *SyncDict[K, V]) Get(key K, fallback ...V) V {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.dict.Get(key, fallback...)
+}
+
+// Returns the existing value for the key if present. Otherwise sets and returns the given value.
+// Returns a second boolean value which is true if the value was already present.
+func (d *SyncDict[K, V]) GetOrSet(key K, value V) (V, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if current, ok := d.dict[key]; ok {
+		return current, true
+	}
+	d.dict[key] = value
+	return value, false
+}
+
+// Returns true if the dictionary contains the given key.
+func (d *SyncDict[K, V]) Has(key K) bool {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.dict.Has(key)
+}
+
+// Returns an array of dictionary items (a struct with Key and Value fields)
+func (d *SyncDict[K, V]) Items() []DictItem[K, V] {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.dict.Items()
+}
+
+// Returns an array of keys present in the dictionary
+func (d *SyncDict[K, V]) Keys() []K {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.dict.Keys()
+}
+
+// Returns the number of items in the dictionary
+func (d *SyncDict[K, V]) Len() int {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return len(d.dict)
+}
+
+// Removes the given key from the dictionary and returns it's associated value.
+// If the key is not present in the dictionary, a fallback is returned if provided.
+// Otherwise a zero-value is returned.
+// Returns a second boolean value which is true if the key was present in the dictionary.
+func (d *SyncDict[K, V]) Pop(key K, fallback ...V) (V, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.dict.Pop(key, fallback...)
+}
+
+// Sets the value for the given key
+func (d *SyncDict[K, V]) Set(key K, value V) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.dict[key] = value
+}
+
+// Sets the value for the given key if the key is not already present.
+// Returns the value for the given key.
+func (d *SyncDict[K, V]) SetDefault(key K, value V) V {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.dict.SetDefault(key, value)
+}
+
+func (d *SyncDict[K, V]) String() string {
+	return fmt.Sprintf("%v", d.ToDict())
+}
+
+// Sets the value for the key and returns the previous value, if any.
+// Returns a second boolean value which is true if the key was present.
+func (d *SyncDict[K, V]) Swap(key K, value V) (V, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	previous, ok := d.dict[key]
+	d.dict[key] = value
+	return previous, ok
+}
+
+// Returns a copy of the dictionary's current contents as a Dict
+func (d *SyncDict[K, V]) ToDict() Dict[K, V] {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.dict.Copy()
+}
+
+// Updates the keys and values from the given dictionary
+func (d *SyncDict[K, V]) Update(dict Dict[K, V]) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.dict.Update(dict)
+}
+
+// Returns an array of the values of the dictionary
+func (d *SyncDict[K, V]) Values() []V {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.dict.Values()
+}
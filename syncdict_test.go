@@ -0,0 +1,99 @@
+package godino
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyncDict(t *testing.T) {
+	t.Run("should behave like a dictionary", func(t *testing.T) {
+		d := NewSyncDict(getDict())
+		d.Set("mango", 1)
+		d.Update(Dict[string, int]{"kiwi": 4})
+		assert.Equal(t, 5, d.Len())
+		assert.Equal(t, 5, d.Get("apple"))
+		assert.Equal(t, 10, d.Get("pear", 10))
+		assert.True(t, d.Has("kiwi"))
+		assert.Equal(t, 4, d.SetDefault("kiwi", 9))
+		value, ok := d.Pop("kiwi")
+		assert.Equal(t, 4, value)
+		assert.True(t, ok)
+		d.Delete("mango")
+		assert.Equal(t, getDict(), d.ToDict())
+		assert.ElementsMatch(t, []string{"apple", "banana", "orange"}, d.Keys())
+		assert.ElementsMatch(t, []int{5, 3, 2}, d.Values())
+		assert.Len(t, d.Items(), 3)
+		d.Clear()
+		assert.Equal(t, 0, d.Len())
+	})
+
+	t.Run("should not share the dictionary it was created from", func(t *testing.T) {
+		dict := getDict()
+		d := NewSyncDict(dict)
+		d.Set("mango", 1)
+		assert.False(t, dict.Has("mango"))
+	})
+
+	t.Run("should get or set values", func(t *testing.T) {
+		d := NewSyncDict(getDict())
+		value, loaded := d.GetOrSet("apple", 10)
+		assert.Equal(t, 5, value)
+		assert.True(t, loaded)
+		value, loaded = d.GetOrSet("mango", 10)
+		assert.Equal(t, 10, value)
+		assert.False(t, loaded)
+		assert.Equal(t, 10, d.Get("mango"))
+	})
+
+	t.Run("should swap values", func(t *testing.T) {
+		d := NewSyncDict(getDict())
+		previous, loaded := d.Swap("apple", 1)
+		assert.Equal(t, 5, previous)
+		assert.True(t, loaded)
+		previous, loaded = d.Swap("mango", 1)
+		assert.Equal(t, 0, previous)
+		assert.False(t, loaded)
+	})
+
+	t.Run("should compare and swap or delete values", func(t *testing.T) {
+		d := NewSyncDict(getDict())
+		assert.False(t, d.CompareAndSwap("apple", 4, 6))
+		assert.True(t, d.CompareAndSwap("apple", 5, 6))
+		assert.Equal(t, 6, d.Get("apple"))
+		assert.False(t, d.CompareAndSwap("mango", 0, 1))
+		assert.False(t, d.Has("mango"))
+		assert.False(t, d.CompareAndDelete("apple", 5))
+		assert.True(t, d.CompareAndDelete("apple", 6))
+		assert.False(t, d.Has("apple"))
+	})
+
+	t.Run("should panic when comparing values which are not comparable", func(t *testing.T) {
+		d := NewSyncDict(Dict[string, []int]{"a": {1}})
+		assert.Panics(t, func() { d.CompareAndSwap("a", []int{1}, []int{2}) })
+	})
+
+	t.Run("should be safe for concurrent use", func(t *testing.T) {
+		d := NewSyncDict(Dict[string, int]{"count": 0})
+		var wg sync.WaitGroup
+		for g := 0; g < 8; g++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for i := 0; i < 200; i++ {
+					for {
+						current := d.Get("count")
+						if d.CompareAndSwap("count", current, current+1) {
+							break
+						}
+					}
+					d.GetOrSet("other", i)
+					d.Items()
+				}
+			}()
+		}
+		wg.Wait()
+		assert.Equal(t, 1600, d.Get("count"))
+	})
+}
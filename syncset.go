@@ -0,0 +1,103 @@
+package godino
+
+import (
+	"fmt"
+	"sync"
+)
+
+// A set which is safe for concurrent use by multiple goroutines
+type SyncSet[T comparable] struct {
+	mu  sync.RWMutex
+	set Set[T]
+}
+
+// Returns a new concurrency safe set containing the values provided
+func NewSyncSet[T comparable](values ...T) *SyncSet[T] {
+	return &SyncSet[T]{set: NewSet(values...)}
+}
+
+// Adds element(s) to the set
+func (s *SyncSet[T]) Add(values ...T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.set.Add(values...)
+}
+
+// Adds the element if it is not already present, as a single atomic operation.
+// Returns true if the element was added.
+func (s *SyncSet[T]) AddIfAbsent(value T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.set.Has(value) {
+		return false
+	}
+	s.set.Add(value)
+	return true
+}
+
+// Removes all the elements from the set
+func (s *SyncSet[T]) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.set = NewSet[T]()
+}
+
+// Remove the specified item. If the item is not present this is a noop
+func (s *SyncSet[T]) Discard(value T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.set.Discard(value)
+}
+
+// Returns true if the set contains the specified element
+func (s *SyncSet[T]) Has(value T) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.Has(value)
+}
+
+// Returns the number of elements in the set
+func (s *SyncSet[T]) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.set)
+}
+
+// Returns the elements contained by the set. Elements are unordered.
+func (s *SyncSet[T]) Members() []T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.Members()
+}
+
+// Removes and returns an element from the set and an error if the set is empty
+func (s *SyncSet[T]) Pop() (T, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.set.Pop()
+}
+
+// Removes the specified element from the set. Returns true if the element was in the set
+func (s *SyncSet[T]) Remove(value T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.set.Remove(value)
+}
+
+func (s *SyncSet[T]) String() string {
+	return fmt.Sprintf("%v", s.Members())
+}
+
+// Returns a copy of the set's current contents as a Set
+func (s *SyncSet[T]) ToSet() Set[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.Copy()
+}
+
+// Adds all the items from the given sets
+func (s *SyncSet[T]) Update(sets ...Set[T]) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.set.Update(sets...)
+}
@@ -0,0 +1,58 @@
+package godino
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyncSet(t *testing.T) {
+	t.Run("should behave like a set", func(t *testing.T) {
+		set := NewSyncSet(1, 2)
+		set.Add(3, 3)
+		set.Update(NewSet(4))
+		assert.Equal(t, 4, set.Len())
+		assert.True(t, set.Has(4))
+		assert.True(t, set.Remove(4))
+		assert.False(t, set.Remove(4))
+		set.Discard(3)
+		assert.ElementsMatch(t, []int{1, 2}, set.Members())
+		assert.Equal(t, NewSet(1, 2), set.ToSet())
+		v, err := set.Pop()
+		assert.NoError(t, err)
+		assert.False(t, set.Has(v))
+		set.Clear()
+		_, err = set.Pop()
+		assert.Error(t, err)
+	})
+
+	t.Run("should add absent elements", func(t *testing.T) {
+		set := NewSyncSet(1)
+		assert.False(t, set.AddIfAbsent(1))
+		assert.True(t, set.AddIfAbsent(2))
+		assert.True(t, set.Has(2))
+	})
+
+	t.Run("should be safe for concurrent use", func(t *testing.T) {
+		set := NewSyncSet[int]()
+		added := make([]int, 8)
+		var wg sync.WaitGroup
+		for g := 0; g < 8; g++ {
+			wg.Add(1)
+			go func(g int) {
+				defer wg.Done()
+				for i := 0; i < 500; i++ {
+					if set.AddIfAbsent(i) {
+						added[g]++
+					}
+					set.Has(i)
+					set.Len()
+				}
+			}(g)
+		}
+		wg.Wait()
+		assert.Equal(t, 500, set.Len())
+		assert.Equal(t, 500, Sum(added...))
+	})
+}